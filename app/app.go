@@ -38,6 +38,7 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 
 	_ "github.com/axiome-pro/axm-node/x/distribution" // import for side-effects
 	_ "github.com/axiome-pro/axm-node/x/slashing"     // import for side-effects
@@ -53,6 +54,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/authz/module"   // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/bank"           // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"      // import for side-effects
+	_ "github.com/cosmos/cosmos-sdk/x/crisis"         // import for side-effects
 	_ "github.com/cosmos/cosmos-sdk/x/mint"           // import for side-effects
 )
 
@@ -84,6 +86,7 @@ type AxmApp struct {
 	SlashingKeeper        slashigkeeper.Keeper
 	DistrKeeper           distrkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	CrisisKeeper          *crisiskeeper.Keeper
 	ReferralKeeper        referral.Keeper
 	VoteKeeper            vote.Keeper
 	UpgradeKeeper         *upgradekeeper.Keeper
@@ -153,6 +156,7 @@ func NewAxmApp(
 		&app.VoteKeeper,
 		&app.UpgradeKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.CrisisKeeper,
 		&app.WasmKeeper,
 		&app.AuthzKeeper,
 	); err != nil {
@@ -168,6 +172,9 @@ func NewAxmApp(
 
 	/****  Module Options ****/
 
+	// register the invariants asserted by crisis every inv-check-period blocks
+	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+
 	app.RegisterUpgradeHandlers()
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
@@ -187,6 +194,11 @@ func (app *AxmApp) LegacyAmino() *codec.LegacyAmino {
 	return app.legacyAmino
 }
 
+// AppCodec returns AxmApp's app codec.
+func (app *AxmApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 func (app *AxmApp) GetKey(storeKey string) *storetypes.KVStoreKey {
 	sk := app.UnsafeFindStoreKey(storeKey)
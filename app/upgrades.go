@@ -3,15 +3,19 @@ package app
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	"github.com/axiome-pro/axm-node/x/referral"
 	"github.com/axiome-pro/axm-node/x/referral/keeper"
 	stakingkeeper "github.com/axiome-pro/axm-node/x/staking/keeper"
 	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
-	upgradetypes "cosmossdk.io/x/upgrade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 
+	appparams "github.com/axiome-pro/axm-node/app/params"
 	referraltypes "github.com/axiome-pro/axm-node/x/referral/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	// CosmWasm params
@@ -25,6 +29,10 @@ const UpgradeNamev200 = "v2.0.0"
 const UpgradeNameV210 = "v2.1.0"
 const UpgradeNameV220 = "v2.2.0"
 const UpgradeNameV221 = "v2.2.1"
+const UpgradeNameV230 = "v2.3.0"
+
+// crisisConstantFee is the fee for a MsgVerifyInvariant, 1000 axm.
+var crisisConstantFee = math.NewInt(1_000_000_000)
 
 func (app *AxmApp) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
@@ -119,6 +127,53 @@ func (app *AxmApp) RegisterUpgradeHandlers() {
 			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
 		},
 	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeNameV230,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			err := app.ReferralKeeper.UpgradeInitParamsV230(sdkCtx)
+			if err != nil {
+				return nil, err
+			}
+			// LevelDelegated is introduced in v2.3.0, the repair fills it in and fixes drifted aggregations on the way
+			err = app.ReferralKeeper.StartNetworkRepair(sdkCtx)
+			if err != nil {
+				return nil, err
+			}
+			err = app.VoteKeeper.UpgradeInitParamsV230(sdkCtx)
+			if err != nil {
+				return nil, err
+			}
+			err = app.VoteKeeper.UpgradeProposalsV230(sdkCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			vm, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			// crisis is added in v2.3.0, its default genesis fee is in the SDK bond denom
+			err = app.CrisisKeeper.ConstantFee.Set(ctx, sdk.NewCoin(appparams.DefaultBondDenom, crisisConstantFee))
+			if err != nil {
+				return nil, err
+			}
+			return vm, nil
+		},
+	)
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+	if upgradeInfo.Name == UpgradeNameV230 && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		// crisis is added in v2.3.0
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{crisistypes.StoreKey},
+		}))
+	}
 }
 
 func upgradeToV102(ctx context.Context, k referral.Keeper) error {
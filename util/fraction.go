@@ -93,6 +93,13 @@ func Permille(x int64) Fraction {
 
 func FractionInt(x int64) Fraction { return NewFraction(x, 1) }
 
+func FractionBigInt(x *big.Int) Fraction {
+	return Fraction{
+		num:   (&big.Int{}).Set(x),
+		denom: big.NewInt(1),
+	}
+}
+
 func FractionZero() Fraction { return FractionInt(0) }
 
 func ParseFraction(s string) (Fraction, error) {
@@ -54,6 +54,57 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query account delegated coins calculated in referral module",
 					Example:   fmt.Sprintf(`Example: $ %s query referral coins [acc_address]`, version.AppName),
 
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "acc_address"},
+					},
+				},
+				{
+					RpcMethod: "Descendants",
+					Use:       "descendants [acc_address]",
+					Short:     "Query account structure with referral info depth-first",
+					Example:   fmt.Sprintf(`Example: $ %s query referral descendants [acc_address] --max-depth 3 --limit 50`, version.AppName),
+
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "acc_address"},
+					},
+				},
+				{
+					RpcMethod: "Ancestors",
+					Use:       "ancestors [acc_address]",
+					Short:     "Query account upline and which ancestors would receive the delegating award",
+					Example:   fmt.Sprintf(`Example: $ %s query referral ancestors [acc_address]`, version.AppName),
+
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "acc_address"},
+					},
+				},
+				{
+					RpcMethod: "SimulateDelegationFees",
+					Use:       "simulate-delegation-fees [acc_address] [amount]",
+					Short:     "Query how referral fees would be paid for a delegation of the amount",
+					Example:   fmt.Sprintf(`Example: $ %s query referral simulate-delegation-fees [acc_address] 1000000000`, version.AppName),
+
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "acc_address"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "Earnings",
+					Use:       "earnings [acc_address]",
+					Short:     "Query referral fees received by the account",
+					Example:   fmt.Sprintf(`Example: $ %s query referral earnings [acc_address]`, version.AppName),
+
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "acc_address"},
+					},
+				},
+				{
+					RpcMethod: "StatusHistory",
+					Use:       "status-history [acc_address]",
+					Short:     "Query the latest status changes of the account",
+					Example:   fmt.Sprintf(`Example: $ %s query referral status-history [acc_address]`, version.AppName),
+
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "acc_address"},
 					},
@@ -27,6 +27,7 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 
 	referralTxCmd.AddCommand(
 		NewRegisterReferralCmd(ac),
+		NewTransferReferralCmd(ac),
 		CmdBurnPartnerFee(),
 	)
 
@@ -113,3 +114,48 @@ $ %s tx referral register-referral %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --f
 
 	return cmd
 }
+
+// NewTransferReferralCmd returns a CLI command handler for creating a MsgTransferReferral transaction.
+func NewTransferReferralCmd(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "transfer-referral [new-referrer-address]",
+		Short: "move the account together with its structure under a new referrer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move the account together with its whole structure under a new referrer.
+
+The transaction has to be signed by both the account (--from) and the new referrer. The current referrer
+is not asked: the account leaves it on its own will. Generate the transaction, then have both parties sign it:
+
+Example:
+$ %s tx referral transfer-referral %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey --generate-only > tx.json
+$ %s tx sign tx.json --from mykey > tx.signed.json
+$ %s tx sign tx.signed.json --from newreferrerkey > tx.signed2.json
+$ %s tx broadcast tx.signed2.json
+`,
+				version.AppName, bech32PrefixAccAddr, version.AppName, version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			referral := clientCtx.GetFromAddress()
+			newReferrer, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferReferral(referral, newReferrer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,6 +14,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		data.TopLevelAccounts,
 		data.OtherAccounts,
 		data.Downgrades,
+		data.Earnings,
+		data.SourceEarnings,
+		data.StatusHistory,
 	); err != nil {
 		panic(err)
 	}
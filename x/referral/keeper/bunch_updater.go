@@ -57,6 +57,7 @@ type bunchUpdater struct {
 	ctx       sdk.Context
 	data      []kvRecord
 	callbacks callbacks
+	params    *types.Params
 }
 
 func newBunchUpdater(k Keeper, ctx sdk.Context) *bunchUpdater {
@@ -110,8 +111,17 @@ func (bu *bunchUpdater) get(acc string) (value types.Info, err error) {
 	return value, err
 }
 
-func (bu bunchUpdater) StatusDowngradeAfter() time.Duration {
-	return time.Duration(bu.k.GetParams(bu.ctx).StatusDowngradePeriod) * time.Second
+// getParams returns module params, reading them from the store only once per bunch.
+func (bu *bunchUpdater) getParams() types.Params {
+	if bu.params == nil {
+		params := bu.k.GetParams(bu.ctx)
+		bu.params = &params
+	}
+	return *bu.params
+}
+
+func (bu *bunchUpdater) StatusDowngradeAfter() time.Duration {
+	return time.Duration(bu.getParams().StatusDowngradePeriod) * time.Second
 }
 
 func (bu *bunchUpdater) update(acc string, checkForStatusUpdate bool, callback func(value *types.Info) error) (err error) {
@@ -131,13 +141,13 @@ func (bu *bunchUpdater) update(acc string, checkForStatusUpdate bool, callback f
 		bu.k.Logger(bu.ctx).Info("Cannot update, no such account", "addr", acc)
 		return nil
 	}
-	value.Normalize()
+	value.Normalize(bu.getParams().NetworkDepth())
 	err = callback(&value)
 	if err != nil {
 		return errors.Wrap(err, "callback failed")
 	}
 	if checkForStatusUpdate {
-		checkResult, err := checkStatusRequirements(value.Status, value)
+		checkResult, err := checkStatusRequirements(bu.getParams(), value.Status, value)
 		if err != nil {
 			return err
 		}
@@ -149,6 +159,10 @@ func (bu *bunchUpdater) update(acc string, checkForStatusUpdate bool, callback f
 				if err != nil {
 					return err
 				}
+				err = bu.k.recordStatusChange(bu.ctx, acc, value.Status, value.Status, types.STATUS_CHANGE_REASON_DOWNGRADE_SCHEDULED)
+				if err != nil {
+					return err
+				}
 				util.EmitEvent(bu.ctx,
 					&types.EventStatusWillBeDowngraded{
 						Address: acc,
@@ -162,6 +176,10 @@ func (bu *bunchUpdater) update(acc string, checkForStatusUpdate bool, callback f
 				if err != nil {
 					return err
 				}
+				err = bu.k.recordStatusChange(bu.ctx, acc, value.Status, value.Status, types.STATUS_CHANGE_REASON_DOWNGRADE_CANCELED)
+				if err != nil {
+					return err
+				}
 				value.StatusDowngradeAt = nil
 				util.EmitEvent(bu.ctx,
 					&types.EventStatusDowngradeCanceled{
@@ -176,7 +194,7 @@ func (bu *bunchUpdater) update(acc string, checkForStatusUpdate bool, callback f
 				}
 				nextStatus++
 
-				checkResult, err = checkStatusRequirements(nextStatus, value)
+				checkResult, err = checkStatusRequirements(bu.getParams(), nextStatus, value)
 				if err != nil {
 					return err
 				}
@@ -186,6 +204,10 @@ func (bu *bunchUpdater) update(acc string, checkForStatusUpdate bool, callback f
 				}
 			}
 			if nextStatus > value.Status {
+				err = bu.k.recordStatusChange(bu.ctx, acc, value.Status, nextStatus, types.STATUS_CHANGE_REASON_UPGRADE)
+				if err != nil {
+					return err
+				}
 				util.EmitEvent(bu.ctx,
 					&types.EventStatusUpdated{
 						Address: acc,
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// addEarning adds the referral fee paid to the beneficiary to its running totals. The per source total is kept only if
+// trackSources is set (see Params.TrackEarningSources).
+func (k Keeper) addEarning(ctx sdk.Context, beneficiary, source, denom string, level uint32, amount math.Int, trackSources bool) error {
+	key := collections.Join3(beneficiary, denom, level)
+	total, err := k.Earnings.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+	if err = k.Earnings.Set(ctx, key, total.Add(amount)); err != nil {
+		return err
+	}
+
+	if !trackSources {
+		return nil
+	}
+	sourceKey := collections.Join3(beneficiary, source, denom)
+	total, err = k.SourceEarnings.Get(ctx, sourceKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+	return k.SourceEarnings.Set(ctx, sourceKey, total.Add(amount))
+}
+
+// GetEarnings returns per level earnings of the beneficiary.
+func (k Keeper) GetEarnings(ctx sdk.Context, beneficiary string) ([]types.Earning, error) {
+	var result []types.Earning
+	rng := collections.NewPrefixedTripleRange[string, string, uint32](beneficiary)
+	err := k.Earnings.Walk(ctx, rng, func(key collections.Triple[string, string, uint32], amount math.Int) (bool, error) {
+		result = append(result, types.Earning{
+			Beneficiary: key.K1(),
+			Denom:       key.K2(),
+			Level:       key.K3(),
+			Amount:      amount,
+		})
+		return false, nil
+	})
+	return result, err
+}
+
+// ExportEarnings returns all the earnings records for genesis.
+func (k Keeper) ExportEarnings(ctx sdk.Context) ([]types.Earning, []types.SourceEarning, error) {
+	var (
+		earnings       []types.Earning
+		sourceEarnings []types.SourceEarning
+	)
+	if err := k.Earnings.Walk(ctx, nil, func(key collections.Triple[string, string, uint32], amount math.Int) (bool, error) {
+		earnings = append(earnings, types.Earning{
+			Beneficiary: key.K1(),
+			Denom:       key.K2(),
+			Level:       key.K3(),
+			Amount:      amount,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := k.SourceEarnings.Walk(ctx, nil, func(key collections.Triple[string, string, string], amount math.Int) (bool, error) {
+		sourceEarnings = append(sourceEarnings, types.SourceEarning{
+			Beneficiary: key.K1(),
+			Source:      key.K2(),
+			Denom:       key.K3(),
+			Amount:      amount,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	return earnings, sourceEarnings, nil
+}
+
+// ImportEarnings stores earnings records from genesis.
+func (k Keeper) ImportEarnings(ctx sdk.Context, earnings []types.Earning, sourceEarnings []types.SourceEarning) error {
+	for _, e := range earnings {
+		if err := k.Earnings.Set(ctx, collections.Join3(e.Beneficiary, e.Denom, e.Level), e.Amount); err != nil {
+			return err
+		}
+	}
+	for _, e := range sourceEarnings {
+		if err := k.SourceEarnings.Set(ctx, collections.Join3(e.Beneficiary, e.Source, e.Denom), e.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// CheckStatusRequirements exposes checkStatusRequirements to the keeper tests.
+var CheckStatusRequirements = checkStatusRequirements
+
+// SetInfo stores the account info as is, so that the tests can corrupt it.
+func (k Keeper) SetInfo(ctx sdk.Context, acc string, info types.Info) error {
+	return k.set(ctx, acc, info)
+}
@@ -98,7 +98,17 @@ func (k Keeper) ExportToGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 		}
 	}
 
-	return types.NewGenesisState(params, topLevelRefs, other, downgrades), nil
+	earnings, sourceEarnings, err := k.ExportEarnings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statusHistory, err := k.ExportStatusHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, topLevelRefs, other, downgrades, earnings, sourceEarnings, statusHistory), nil
 }
 
 func (k Keeper) ImportFromGenesis(
@@ -106,6 +116,9 @@ func (k Keeper) ImportFromGenesis(
 	topLevel []*types.RefInfo,
 	otherAccounts []types.Refs,
 	downgrades []types.Downgrade,
+	earnings []types.Earning,
+	sourceEarnings []types.SourceEarning,
+	statusHistory []types.AccountStatusHistory,
 ) error {
 	k.Logger(ctx).Info("... top level accounts")
 	for _, top := range topLevel {
@@ -145,5 +158,10 @@ func (k Keeper) ImportFromGenesis(
 	if err := bu.commit(); err != nil {
 		return err
 	}
-	return nil
+	k.Logger(ctx).Info("... earnings")
+	if err := k.ImportEarnings(ctx, earnings, sourceEarnings); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("... status history")
+	return k.ImportStatusHistory(ctx, statusHistory)
 }
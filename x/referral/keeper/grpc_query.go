@@ -2,11 +2,19 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	"github.com/axiome-pro/axm-node/x/referral/types"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Querier{}
@@ -84,3 +92,287 @@ func (qs Querier) Params(ctx context.Context, _ *types.ParamsRequest) (*types.Pa
 
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+// Descendants walks the account structure depth-first, children in the store order. Pagination is key based, the key
+// is the address of the last returned descendant, so a page costs about limit*max_depth reads whatever its position.
+// The limit is capped at query.DefaultLimit.
+func (qs Querier) Descendants(ctx context.Context, request *types.DescendantsRequest) (*types.DescendantsResponse, error) {
+	if request.AccAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account address")
+	}
+	if _, err := sdk.AccAddressFromBech32(request.AccAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var (
+		limit  uint64 = query.DefaultLimit
+		cursor string
+	)
+	if p := request.Pagination; p != nil {
+		if p.Offset != 0 || p.CountTotal {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("only key based pagination is supported")
+		}
+		if p.Limit != 0 && p.Limit < limit {
+			limit = p.Limit
+		}
+		cursor = string(p.Key)
+	}
+	maxDepth := request.MaxDepth
+	if networkDepth := uint32(qs.Keeper.GetParams(sdkCtx).NetworkDepth()); maxDepth == 0 || maxDepth > networkDepth {
+		maxDepth = networkDepth
+	}
+
+	if !qs.exists(sdkCtx, request.AccAddress) {
+		return nil, errors.Wrap(types.ErrNotFound, request.AccAddress)
+	}
+
+	var (
+		node   = request.AccAddress
+		level  uint32
+		result = make([]types.DescendantNode, 0)
+		more   bool
+		err    error
+	)
+	if cursor != "" {
+		if level, err = qs.getDescendantLevel(sdkCtx, request.AccAddress, cursor, maxDepth); err != nil {
+			return nil, err
+		}
+		node = cursor
+	}
+	for {
+		if node, level, err = qs.nextDescendant(sdkCtx, node, level, maxDepth); err != nil {
+			return nil, err
+		}
+		if node == "" {
+			break
+		}
+		if uint64(len(result)) == limit {
+			more = true
+			break
+		}
+		item, err := qs.getDescendantNode(sdkCtx, node, level)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+
+	pageRes := &query.PageResponse{}
+	if more {
+		pageRes.NextKey = []byte(result[len(result)-1].Address)
+	}
+	return &types.DescendantsResponse{
+		Descendants: result,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// nextDescendant returns the descendant following the node at the level in the depth-first order, or an empty string
+// if the walk is over. The walk never goes above level 1, so it stays within the subtree of the requested account.
+func (qs Querier) nextDescendant(ctx sdk.Context, node string, level, maxDepth uint32) (string, uint32, error) {
+	if level < maxDepth {
+		child, err := qs.Keeper.getChildAfter(ctx, node, "")
+		if err != nil {
+			return "", 0, errors.Wrap(err, "cannot obtain account children data")
+		}
+		if child != "" {
+			return child, level + 1, nil
+		}
+	}
+	for ; level > 0; level-- {
+		parent, err := qs.Keeper.GetParent(ctx, node)
+		if err != nil {
+			return "", 0, err
+		}
+		sibling, err := qs.Keeper.getChildAfter(ctx, parent, node)
+		if err != nil {
+			return "", 0, errors.Wrap(err, "cannot obtain account children data")
+		}
+		if sibling != "" {
+			return sibling, level, nil
+		}
+		node = parent
+	}
+	return "", 0, nil
+}
+
+// getDescendantLevel returns the distance from the account down to the descendant. It fails if the descendant is not
+// within maxDepth levels below the account.
+func (qs Querier) getDescendantLevel(ctx sdk.Context, acc, descendant string, maxDepth uint32) (uint32, error) {
+	node := descendant
+	for level := uint32(1); level <= maxDepth; level++ {
+		parent, err := qs.Keeper.GetParent(ctx, node)
+		if err != nil {
+			return 0, err
+		}
+		if parent == acc {
+			return level, nil
+		}
+		if parent == "" {
+			break
+		}
+		node = parent
+	}
+	return 0, sdkerrors.ErrInvalidRequest.Wrap("invalid pagination key")
+}
+
+// parseOffsetPagination returns offset and limit of the requested page. For queries not backed by a single store
+// prefix, `next_key` holds the offset of the next page encoded as big-endian uint64.
+func parseOffsetPagination(p *query.PageRequest) (offset, limit uint64, countTotal bool, err error) {
+	limit = query.DefaultLimit
+	if p == nil {
+		return 0, limit, false, nil
+	}
+	if len(p.Key) != 0 {
+		if len(p.Key) != 8 {
+			return 0, 0, false, sdkerrors.ErrInvalidRequest.Wrap("invalid pagination key")
+		}
+		offset = binary.BigEndian.Uint64(p.Key)
+	} else {
+		offset = p.Offset
+		countTotal = p.CountTotal
+	}
+	if p.Limit != 0 {
+		limit = p.Limit
+	}
+	return offset, limit, countTotal, nil
+}
+
+func offsetPageResponse(offset, limit, total uint64, more, countTotal bool) *query.PageResponse {
+	pageRes := &query.PageResponse{}
+	if more {
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, offset+limit)
+	}
+	if countTotal {
+		pageRes.Total = total
+	}
+	return pageRes
+}
+
+func (qs Querier) getDescendantNode(ctx sdk.Context, acc string, level uint32) (types.DescendantNode, error) {
+	info, err := qs.Keeper.Get(ctx, acc)
+	if err != nil {
+		return types.DescendantNode{}, errors.Wrap(err, "cannot obtain account data")
+	}
+	childrenCount, err := qs.Keeper.countChildren(ctx, acc)
+	if err != nil {
+		return types.DescendantNode{}, errors.Wrap(err, "cannot obtain account children data")
+	}
+	return types.DescendantNode{
+		Address:       acc,
+		Referrer:      info.Referrer,
+		Level:         level,
+		Status:        info.Status,
+		Active:        info.Active,
+		SelfDelegated: *info.SelfDelegated,
+		TeamDelegated: *info.TeamDelegated,
+		ChildrenCount: childrenCount,
+	}, nil
+}
+
+func (qs Querier) Ancestors(ctx context.Context, request *types.AncestorsRequest) (*types.AncestorsResponse, error) {
+	if request.AccAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account address")
+	}
+	if _, err := sdk.AccAddressFromBech32(request.AccAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	levels, err := qs.Keeper.GetAncestorLevels(sdkCtx, request.AccAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot obtain account ancestors")
+	}
+	return &types.AncestorsResponse{Ancestors: levels}, nil
+}
+
+func (qs Querier) SimulateDelegationFees(ctx context.Context, request *types.SimulateDelegationFeesRequest) (*types.SimulateDelegationFeesResponse, error) {
+	if request.Amount.IsNil() || !request.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("amount must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	result, err := qs.Keeper.SimulateDelegationFees(sdkCtx, request.AccAddress, request.Amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot simulate delegation fees")
+	}
+	return result, nil
+}
+
+func (qs Querier) Earnings(ctx context.Context, request *types.EarningsRequest) (*types.EarningsResponse, error) {
+	if request.AccAddress == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty account address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	levels, err := qs.Keeper.GetEarnings(sdkCtx, request.AccAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot obtain earnings")
+	}
+	total := sdk.NewCoins()
+	for _, e := range levels {
+		total = total.Add(sdk.NewCoin(e.Denom, e.Amount))
+	}
+
+	offset, limit, countTotal, err := parseOffsetPagination(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		sources = make([]types.SourceEarning, 0)
+		count   uint64
+		more    bool
+	)
+	rng := collections.NewPrefixedTripleRange[string, string, string](request.AccAddress)
+	err = qs.Keeper.SourceEarnings.Walk(sdkCtx, rng, func(key collections.Triple[string, string, string], amount math.Int) (bool, error) {
+		if count >= offset+limit {
+			more = true
+			if !countTotal {
+				return true, nil
+			}
+		} else if count >= offset {
+			sources = append(sources, types.SourceEarning{
+				Beneficiary: key.K1(),
+				Source:      key.K2(),
+				Denom:       key.K3(),
+				Amount:      amount,
+			})
+		}
+		count++
+		return false, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot obtain source earnings")
+	}
+
+	return &types.EarningsResponse{
+		Total:      total,
+		Levels:     levels,
+		Sources:    sources,
+		Pagination: offsetPageResponse(offset, limit, count, more, countTotal),
+	}, nil
+}
+
+func (qs Querier) StatusHistory(ctx context.Context, request *types.StatusHistoryRequest) (*types.StatusHistoryResponse, error) {
+	if request.AccAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account address")
+	}
+	if _, err := sdk.AccAddressFromBech32(request.AccAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	records, err := qs.Keeper.GetStatusHistory(sdkCtx, request.AccAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot obtain status history")
+	}
+
+	return &types.StatusHistoryResponse{
+		Records: records,
+	}, nil
+}
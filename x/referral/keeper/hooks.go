@@ -61,6 +61,9 @@ func (k Keeper) performDowngrade(ctx sdk.Context, acc string) error {
 			return nil
 		}
 		nextStatus := value.Status - 1
+		if err := k.recordStatusChange(ctx, acc, value.Status, nextStatus, types.STATUS_CHANGE_REASON_DOWNGRADE); err != nil {
+			return err
+		}
 		util.EmitEvent(bu.ctx,
 			&types.EventStatusUpdated{
 				Address: acc,
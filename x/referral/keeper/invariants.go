@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
+)
+
+// RegisterInvariants registers all referral invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "network-aggregations",
+		NetworkAggregationsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "self-delegated",
+		SelfDelegatedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "active-threshold",
+		ActiveThresholdInvariant(k))
+}
+
+// AllInvariants runs all invariants of the referral module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NetworkAggregationsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = SelfDelegatedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ActiveThresholdInvariant(k)(ctx)
+	}
+}
+
+// NetworkAggregationsInvariant checks that LevelDelegated, ActiveRefCounts, TeamDelegated and ActiveCount of every
+// account match the values recalculated from its first line. It is skipped while a network repair or rebuild is
+// pending.
+//
+// Every account is checked against its referrals only, which is enough: if each level of every account is the sum of
+// the previous levels of its referrals, the counters match the whole structure.
+func NetworkAggregationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if k.isPending(ctx, types.NetworkRepairKey) || k.isPending(ctx, types.NetworkRebuildKey) || k.isPending(ctx, types.TeamRebuildKey) {
+			return sdk.FormatInvariant(types.ModuleName, "network-aggregations", "skipped: network rebuild is pending"), false
+		}
+
+		depth := k.GetParams(ctx).NetworkDepth()
+		var (
+			msg    string
+			broken int
+		)
+		k.iterateInfo(ctx, func(acc string, info types.Info) {
+			levelDelegated, activeRefCounts, err := k.calcLevels(ctx, acc, info, depth)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\t%s: %v\n", acc, err)
+				return
+			}
+			activeCount, err := k.calcTeams(ctx, acc)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\t%s: %v\n", acc, err)
+				return
+			}
+
+			teamDelegated := math.ZeroInt()
+			for _, delegated := range levelDelegated[1:] {
+				teamDelegated = teamDelegated.Add(delegated)
+			}
+			expected := info
+			expected.LevelDelegated = levelDelegated
+			expected.TeamDelegated = &teamDelegated
+			expected.ActiveRefCounts = activeRefCounts
+			expected.ActiveCount = activeCount
+			if !infoMatches(info, expected) {
+				broken++
+				msg += fmt.Sprintf("\t%s: team delegated %v (expected %v), level delegated %v (expected %v), active refs %v (expected %v), active count %v (expected %v)\n",
+					acc,
+					info.TeamDelegated, expected.TeamDelegated,
+					info.LevelDelegated, expected.LevelDelegated,
+					info.ActiveRefCounts, expected.ActiveRefCounts,
+					info.ActiveCount, expected.ActiveCount,
+				)
+			}
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "network-aggregations",
+			fmt.Sprintf("%d accounts have mismatched network aggregations\n%s", broken, msg)), broken != 0
+	}
+}
+
+// SelfDelegatedInvariant checks that SelfDelegated of every account matches its staking delegations. A difference of
+// one token per delegation is tolerated because of share truncation. It is skipped while a network repair is pending.
+func SelfDelegatedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if k.isPending(ctx, types.NetworkRepairKey) {
+			return sdk.FormatInvariant(types.ModuleName, "self-delegated", "skipped: network repair is pending"), false
+		}
+
+		var (
+			msg    string
+			broken int
+		)
+		k.iterateInfo(ctx, func(acc string, info types.Info) {
+			expected, count, err := k.getStaked(ctx, acc)
+			if err != nil {
+				panic(err)
+			}
+			if info.SelfDelegated.Sub(expected).Abs().GT(math.NewInt(count)) {
+				broken++
+				msg += fmt.Sprintf("\t%s: self delegated %v, staking delegations %v\n", acc, info.SelfDelegated, expected)
+			}
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "self-delegated",
+			fmt.Sprintf("%d accounts have mismatched self delegation\n%s", broken, msg)), broken != 0
+	}
+}
+
+// ActiveThresholdInvariant checks that an account is active if and only if its self delegation reaches the activation
+// threshold. It is skipped while an activation sweep or a network repair is in progress.
+func ActiveThresholdInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if k.isPending(ctx, types.ActivationSweepKey) {
+			return sdk.FormatInvariant(types.ModuleName, "active-threshold", "skipped: activation sweep is in progress"), false
+		}
+		if k.isPending(ctx, types.NetworkRepairKey) {
+			return sdk.FormatInvariant(types.ModuleName, "active-threshold", "skipped: network repair is pending"), false
+		}
+
+		threshold := k.GetParams(ctx).ActivationThreshold
+		var (
+			msg    string
+			broken int
+		)
+		k.iterateInfo(ctx, func(acc string, info types.Info) {
+			if info.Active != info.SelfDelegated.GTE(threshold) {
+				broken++
+				msg += fmt.Sprintf("\t%s: active %t, self delegated %v\n", acc, info.Active, info.SelfDelegated)
+			}
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "active-threshold",
+			fmt.Sprintf("%d accounts have activity not matching the threshold %v\n%s", broken, threshold, msg)), broken != 0
+	}
+}
+
+func (k Keeper) isPending(ctx sdk.Context, key []byte) bool {
+	pending, err := k.storeService.OpenKVStore(ctx).Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return pending
+}
+
+func (k Keeper) iterateInfo(ctx sdk.Context, fn func(acc string, info types.Info)) {
+	k.Iterate(ctx, func(acc string, r *types.Info) (changed, checkForStatusUpdate bool) {
+		fn(acc, *r)
+		return false, false
+	})
+}
+
+// getStaked returns coins delegated by the account the same way staking hooks report them, and the number of its
+// delegations.
+func (k Keeper) getStaked(ctx sdk.Context, acc string) (math.Int, int64, error) {
+	delAddr, err := sdk.AccAddressFromBech32(acc)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+	var (
+		total   = math.ZeroInt()
+		count   int64
+		iterErr error
+	)
+	err = k.stakingKeeper.IterateDelegatorDelegations(ctx, delAddr, func(del stakingtypes.Delegation) (stop bool) {
+		valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		total = total.Add(val.TokensFromSharesTruncated(del.Shares).TruncateInt())
+		count++
+		return false
+	})
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+	return total, count, iterErr
+}
+
+func infoMatches(a, b types.Info) bool {
+	if a.Active != b.Active ||
+		a.SelfDelegated == nil || !a.SelfDelegated.Equal(*b.SelfDelegated) ||
+		a.TeamDelegated == nil || !a.TeamDelegated.Equal(*b.TeamDelegated) ||
+		a.ActiveCount == nil || !a.ActiveCount.Eqals(*b.ActiveCount) ||
+		len(a.ActiveRefCounts) != len(b.ActiveRefCounts) ||
+		len(a.LevelDelegated) != len(b.LevelDelegated) {
+		return false
+	}
+	for i := range a.ActiveRefCounts {
+		if a.ActiveRefCounts[i] != b.ActiveRefCounts[i] {
+			return false
+		}
+	}
+	for i := range a.LevelDelegated {
+		if !a.LevelDelegated[i].Equal(b.LevelDelegated[i]) {
+			return false
+		}
+	}
+	return true
+}
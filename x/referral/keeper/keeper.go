@@ -25,6 +25,9 @@ type Keeper struct {
 	bankKeeper          types.BankKeeper
 	stakingKeeper       types.StakingKeeper
 	Params              collections.Item[types.Params]
+	Earnings            collections.Map[collections.Triple[string, string, uint32], math.Int]
+	SourceEarnings      collections.Map[collections.Triple[string, string, string], math.Int]
+	StatusHistory       collections.Map[string, types.StatusHistory]
 	eventHooks          map[string][]func(ctx sdk.Context, acc string) error
 	authority           sdk.AccAddress
 	referralAccountName string
@@ -52,6 +55,19 @@ func NewKeeper(
 		authority:           authority,
 		referralAccountName: referralAccountName,
 	}
+	keeper.Earnings = collections.NewMap(
+		sb, types.EarningsPrefix, "earnings",
+		collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint32Key),
+		sdk.IntValue,
+	)
+	keeper.SourceEarnings = collections.NewMap(
+		sb, types.SourceEarningsPrefix, "source_earnings",
+		collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+		sdk.IntValue,
+	)
+	keeper.StatusHistory = collections.NewMap(
+		sb, types.StatusHistoryPrefix, "status_history", collections.StringKey, codec.CollValue[types.StatusHistory](cdc),
+	)
 	return &keeper
 }
 
@@ -89,7 +105,7 @@ func (k Keeper) AreStatusRequirementsFulfilled(ctx sdk.Context, acc string, s ty
 	if err != nil {
 		return types.StatusCheckResult{Overall: false}, err
 	}
-	return checkStatusRequirements(s, data)
+	return checkStatusRequirements(k.GetParams(ctx), s, data)
 }
 
 // GetDelegatedInNetwork returns total amount of delegated coins in a person's network
@@ -118,8 +134,7 @@ func (k Keeper) OnBalanceChanged(ctx sdk.Context, acc string, dd math.Int) error
 
 		newDelegated := value.SelfDelegated.Add(dd)
 
-		// TODO: move active account threshold to params
-		if newDelegated.Int64() >= 1_000_000_000 {
+		if newDelegated.GTE(bu.getParams().ActivationThreshold) {
 			if !value.Active {
 				changeActivity = true
 				active = true
@@ -138,6 +153,7 @@ func (k Keeper) OnBalanceChanged(ctx sdk.Context, acc string, dd math.Int) error
 		node = value.Referrer
 
 		value.SelfDelegated = &newDelegated
+		value.LevelDelegated[0] = newDelegated
 		return nil
 	}); err != nil {
 		if errors.Is(err, types.ErrNotFound) {
@@ -149,14 +165,15 @@ func (k Keeper) OnBalanceChanged(ctx sdk.Context, acc string, dd math.Int) error
 		}
 	}
 
-	for i := 1; i <= 14; i++ {
+	for i := 1; i <= bu.getParams().NetworkDepth(); i++ {
 		if node == "" {
 			break
 		}
 
 		if err := bu.update(node, true, func(value *types.Info) error {
-			newLevelDelegated := (*value.TeamDelegated).Add(dd)
-			value.TeamDelegated = &newLevelDelegated
+			newTeamDelegated := (*value.TeamDelegated).Add(dd)
+			value.TeamDelegated = &newTeamDelegated
+			value.LevelDelegated[i] = value.LevelDelegated[i].Add(dd)
 			if !dd.IsZero() {
 				bu.addCallback(StakeChangedCallback, node)
 			}
@@ -231,7 +248,7 @@ func (k Keeper) SetActive(ctx sdk.Context, acc string, value, checkAncestorsForS
 			if parent != "" {
 				err2 := bu.update(parent, checkAncestorsForStatusUpdate, func(y *types.Info) error {
 					// if now account active - increment ActiveAgregations
-					activeTeam := x.GetActiveRefsCountFromLevelToLevel(1, 13)
+					activeTeam := x.ActiveTeamSize()
 					// core criteria
 					changeTeamActive(y.ActiveCount, activeTeam, deltaValue)
 					// xby0 criteria
@@ -276,16 +293,16 @@ func (k Keeper) SetActive(ctx sdk.Context, acc string, value, checkAncestorsForS
 		return nil
 	}
 
-	// update 1 to 14 level referrers - update n-teams rule
-	for i := 0; i < 14; i++ {
+	// update all the level referrers - update n-teams rule
+	for i := 0; i < bu.getParams().NetworkDepth(); i++ {
 		if parent == "" {
 			break
 		}
 
 		err = bu.update(parent, checkAncestorsForStatusUpdate, func(x *types.Info) error {
-			oldTeamSize := x.GetActiveRefsCountFromLevelToLevel(1, 13)
+			oldTeamSize := x.ActiveTeamSize()
 			delta(&x.ActiveRefCounts[i+1])
-			newTeamSize := x.GetActiveRefsCountFromLevelToLevel(1, 13)
+			newTeamSize := x.ActiveTeamSize()
 			parent = x.Referrer
 
 			// if account is active and team size changed - update parent status aggregations
@@ -327,8 +344,8 @@ func (k Keeper) MustSetActive(ctx sdk.Context, acc string, value bool, bu *bunch
 
 // MustSetActiveWithoutStatusUpdate updates active referrals but skips status update check after it. So this check MUST
 // be performed from the outer code later. This is useful for massive updates like genesis init, because it allows to
-// avoid excessive checks repeating again and again for the same account (every time any of referrals up to 14 lines
-// down changes its activity).
+// avoid excessive checks repeating again and again for the same account (every time any of referrals down the network
+// changes its activity).
 func (k Keeper) MustSetActiveWithoutStatusUpdate(ctx sdk.Context, acc string, value bool, bu *bunchUpdater) {
 	if err := k.SetActive(ctx, acc, value, false, bu); err != nil {
 		panic(err)
@@ -336,45 +353,64 @@ func (k Keeper) MustSetActiveWithoutStatusUpdate(ctx sdk.Context, acc string, va
 }
 
 func (k Keeper) getReferralFeesCore(ctx sdk.Context, acc string, toAncestors []util.Fraction) ([]types.ReferralFee, util.Fraction, error) {
-	if len(toAncestors) != 14 {
-		return nil, util.Fraction{}, errors.Errorf("toAncestors param must have exactly 14 items (%d found)", len(toAncestors))
+	levels, err := k.getAncestorLevels(ctx, acc, toAncestors)
+	if err != nil {
+		return nil, util.Fraction{}, err
 	}
+
 	excess := util.Percent(0)
-	result := make([]types.ReferralFee, 0, 14)
+	result := make([]types.ReferralFee, 0, len(toAncestors))
+	for _, l := range levels {
+		if l.ReceivesAward {
+			if !l.Award.IsZero() {
+				result = append(result, types.ReferralFee{Beneficiary: l.Address, Ratio: l.Award, Level: l.Level})
+			}
+		} else {
+			excess = excess.Add(l.Award)
+		}
+	}
+
+	return result, excess, nil
+}
+
+// GetAncestorLevels returns the account upline with the delegating award share of every level.
+func (k Keeper) GetAncestorLevels(ctx sdk.Context, acc string) ([]types.AncestorLevel, error) {
+	return k.getAncestorLevels(ctx, acc, k.GetParams(ctx).DelegatingAward.Network)
+}
+
+func (k Keeper) getAncestorLevels(ctx sdk.Context, acc string, toAncestors []util.Fraction) ([]types.AncestorLevel, error) {
+	result := make([]types.AncestorLevel, 0, len(toAncestors))
+	params := k.GetParams(ctx)
 
 	ancestor, err := k.GetParent(ctx, acc)
 	k.Logger(ctx).Info("Get starting at", "anc", ancestor)
 	if err != nil {
-		return nil, util.Fraction{}, err
+		return nil, err
 	}
-	for i := 0; i < 14; i++ {
-		var (
-			data types.Info
-			err  error
-		)
-
-		if ancestor == "" {
-			excess = excess.Add(toAncestors[i])
-			continue
-		}
-
-		data, err = k.Get(ctx, ancestor)
-		if err != nil {
-			return nil, util.Fraction{}, err
+	for i := range toAncestors {
+		level := types.AncestorLevel{
+			Level: uint32(i + 1),
+			Award: toAncestors[i],
 		}
 
-		if i < data.Status.LinesOpened() {
-			if !toAncestors[i].IsZero() {
-				result = append(result, types.ReferralFee{Beneficiary: ancestor, Ratio: toAncestors[i]})
+		if ancestor != "" {
+			data, err := k.Get(ctx, ancestor)
+			if err != nil {
+				return nil, err
 			}
-		} else {
-			excess = excess.Add(toAncestors[i])
+
+			level.Address = ancestor
+			level.Status = data.Status
+			level.LinesOpened = uint32(params.LinesOpened(data.Status))
+			level.ReceivesAward = i < params.LinesOpened(data.Status)
+
+			ancestor = data.Referrer
 		}
 
-		ancestor = data.Referrer
+		result = append(result, level)
 	}
 
-	return result, excess, nil
+	return result, nil
 }
 
 // TODO: should we need to remove this function?
@@ -390,10 +426,11 @@ func (k Keeper) PayUpFees(ctx sdk.Context, acc string, totalAmount math.Int) (re
 	if err != nil {
 		return totalAmount, err
 	}
+	params := k.GetParams(ctx)
 
 	// If uret_mode is enabled, skip burning and uaxm distribution.
 	// Mint uret equal to the fee-share and send to beneficiaries. Remain is unchanged.
-	if k.GetParams(ctx).UretMode {
+	if params.UretMode {
 		cdc := k.accountKeeper.AddressCodec()
 
 		type payout struct {
@@ -404,7 +441,7 @@ func (k Keeper) PayUpFees(ctx sdk.Context, acc string, totalAmount math.Int) (re
 		totalFee := int64(0)
 
 		for _, fee := range fees {
-			x := fee.Ratio.MulInt64(totalAmount.Int64()).Int64()
+			x := feeAmount(fee.Ratio, totalAmount)
 			if x == 0 {
 				continue
 			}
@@ -417,6 +454,10 @@ func (k Keeper) PayUpFees(ctx sdk.Context, acc string, totalAmount math.Int) (re
 				return totalAmount, err
 			}
 
+			if err = k.addEarning(ctx, to, acc, util.ConfigReferralDenom, fee.Level, math.NewInt(x), params.TrackEarningSources); err != nil {
+				return totalAmount, err
+			}
+
 			amount := sdk.NewCoins(sdk.NewCoin(util.ConfigReferralDenom, math.NewInt(x)))
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
@@ -458,7 +499,7 @@ func (k Keeper) PayUpFees(ctx sdk.Context, acc string, totalAmount math.Int) (re
 		return totalAmount, err
 	}
 
-	amountToBurn := math.NewInt(burn.MulInt64(totalAmount.Int64()).Int64())
+	amountToBurn := math.NewInt(feeAmount(burn, totalAmount))
 	coinsToBurn := sdk.NewCoins(sdk.NewCoin(bondDenom, amountToBurn))
 
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, k.referralAccountName, coinsToBurn)
@@ -474,7 +515,7 @@ func (k Keeper) PayUpFees(ctx sdk.Context, acc string, totalAmount math.Int) (re
 	totalFee := int64(0)
 	outputs := make([]banktypes.Output, 0, len(fees))
 	for _, fee := range fees {
-		x := fee.Ratio.MulInt64(totalAmount.Int64()).Int64()
+		x := feeAmount(fee.Ratio, totalAmount)
 		if x == 0 {
 			continue
 		}
@@ -487,6 +528,10 @@ func (k Keeper) PayUpFees(ctx sdk.Context, acc string, totalAmount math.Int) (re
 			return totalAmount, err
 		}
 
+		if err = k.addEarning(ctx, to, acc, bondDenom, fee.Level, math.NewInt(x), params.TrackEarningSources); err != nil {
+			return totalAmount, err
+		}
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeRefFee,
@@ -511,6 +556,11 @@ func (k Keeper) PayUpFees(ctx sdk.Context, acc string, totalAmount math.Int) (re
 	return remain, nil
 }
 
+// feeAmount returns the part of totalAmount taken by the given share.
+func feeAmount(share util.Fraction, totalAmount math.Int) int64 {
+	return share.MulInt64(totalAmount.Int64()).Int64()
+}
+
 func (k Keeper) BurnCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) error {
 	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, acc, k.referralAccountName, amt)
 	if err != nil {
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/axiome-pro/axm-node/x/referral/keeper"
+	referraltestutil "github.com/axiome-pro/axm-node/x/referral/testutil"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	referralKeeper *keeper.Keeper
+	stakingKeeper  *referraltestutil.MockStakingKeeper
+	msgServer      types.MsgServer
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.ModuleName)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := sdktestutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: cmttime.Now(), Height: 10})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := referraltestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	s.ctx = ctx
+	s.stakingKeeper = referraltestutil.NewMockStakingKeeper(ctrl)
+	s.referralKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		referraltestutil.NewMockBankKeeper(ctrl),
+		s.stakingKeeper,
+		authority,
+		types.ModuleName,
+	)
+	s.referralKeeper.SetParams(ctx, types.DefaultParams())
+
+	s.msgServer = keeper.NewMsgServer(*s.referralKeeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
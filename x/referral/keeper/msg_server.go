@@ -76,13 +76,73 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	oldParams := k.GetParams(sdkCtx)
+
 	if err := k.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	if !oldParams.StatusRequirementsEqual(msg.Params) {
+		if err := k.StartStatusSweep(sdkCtx); err != nil {
+			return nil, err
+		}
+	}
+
+	if oldParams.NetworkDepth() != msg.Params.NetworkDepth() {
+		if err := k.ScheduleNetworkRebuild(sdkCtx); err != nil {
+			return nil, err
+		}
+	}
+
+	if !oldParams.ActivationThreshold.Equal(msg.Params.ActivationThreshold) {
+		if err := k.StartActivationSweep(sdkCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+func (k msgServer) TransferReferral(ctx context.Context, msg *types.MsgTransferReferral) (*types.MsgTransferReferralResponse, error) {
+	_, err := k.accountKeeper.AddressCodec().StringToBytes(msg.ReferralAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid referral address: %s", err)
+	}
+
+	_, err = k.accountKeeper.AddressCodec().StringToBytes(msg.NewReferrerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid referrer address: %s", err)
+	}
+
+	if !k.isTransferAuthorized(msg) {
+		return nil, types.ErrTransferSigners
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err = k.Keeper.TransferReferral(sdkCtx, msg.ReferralAddress, msg.NewReferrerAddress); err != nil {
+		return nil, errors.Wrap(err, "unable to transfer referral")
+	}
+
+	return &types.MsgTransferReferralResponse{}, nil
+}
+
+// isTransferAuthorized checks that the transfer is signed either by the authority alone or by both the referral and
+// the new referrer. The consent of the old referrer is not required on purpose: the referral chooses whom to follow,
+// and no one but the authority can move it without its signature.
+func (k msgServer) isTransferAuthorized(msg *types.MsgTransferReferral) bool {
+	switch len(msg.Signers) {
+	case 1:
+		return k.validateAuthority(msg.Signers[0]) == nil
+	case 2:
+		return (msg.Signers[0] == msg.ReferralAddress && msg.Signers[1] == msg.NewReferrerAddress) ||
+			(msg.Signers[0] == msg.NewReferrerAddress && msg.Signers[1] == msg.ReferralAddress)
+	default:
+		return false
+	}
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	_, err := k.accountKeeper.AddressCodec().StringToBytes(authority)
 
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// ScheduleNetworkRebuild starts recalculating depth dependent data of every account. It should be called whenever
+// the network depth changes. The rebuild is performed by BeginBlock in batches, if it is already in progress, it
+// starts over.
+func (k Keeper) ScheduleNetworkRebuild(ctx sdk.Context) error {
+	k.Logger(ctx).Info("Network rebuild scheduled")
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.TeamRebuildKey); err != nil {
+		return err
+	}
+	if err := k.setNetworkPasses(ctx, uint64(k.GetParams(ctx).NetworkDepth())); err != nil {
+		return err
+	}
+	return k.startSweep(ctx, types.NetworkRebuildKey)
+}
+
+// StartNetworkRepair schedules recalculation of every account from the staking delegations: SelfDelegated and Active
+// are reset first, then the network is rebuilt on top of them. The repair is performed by BeginBlock in batches, if
+// it is already in progress, it starts over.
+func (k Keeper) StartNetworkRepair(ctx sdk.Context) error {
+	k.Logger(ctx).Info("Network repair scheduled")
+	return k.startSweep(ctx, types.NetworkRepairKey)
+}
+
+// PerformNetworkRepair resets the next batch of accounts if a network repair is in progress, and schedules the
+// network rebuild once all the accounts are reset. Aggregations of the ancestors are not touched meanwhile, the
+// rebuild recalculates them anyway.
+func (k Keeper) PerformNetworkRepair(ctx sdk.Context) error {
+	if !k.isPending(ctx, types.NetworkRepairKey) {
+		return nil
+	}
+	threshold := k.GetParams(ctx).ActivationThreshold
+	if err := k.sweep(ctx, types.NetworkRepairKey, func(acc string) error {
+		return k.repairAccount(ctx, acc, threshold)
+	}); err != nil {
+		return err
+	}
+	if k.isPending(ctx, types.NetworkRepairKey) {
+		return nil
+	}
+	return k.ScheduleNetworkRebuild(ctx)
+}
+
+// repairAccount resets SelfDelegated of the account to the coins it has delegated, and Active according to it.
+func (k Keeper) repairAccount(ctx sdk.Context, acc string, threshold math.Int) error {
+	info, err := k.Get(ctx, acc)
+	if err != nil {
+		return errors.Wrapf(err, "cannot obtain info for %s", acc)
+	}
+	staked, _, err := k.getStaked(ctx, acc)
+	if err != nil {
+		return errors.Wrapf(err, "cannot obtain delegations of %s", acc)
+	}
+	active := staked.GTE(threshold)
+	if info.SelfDelegated.Equal(staked) && info.Active == active {
+		return nil
+	}
+
+	k.Logger(ctx).Info("Account repaired", "acc", acc,
+		"self_delegated", info.SelfDelegated, "staked", staked, "active", info.Active, "expected_active", active)
+	info.SelfDelegated = &staked
+	info.Active = active
+	return k.set(ctx, acc, info)
+}
+
+// PerformNetworkRebuild recalculates the next batch of accounts if a network rebuild is in progress. The rebuild
+// starts with one level pass per network level: every pass recalculates LevelDelegated, ActiveRefCounts and
+// TeamDelegated of every account from the counters of its first line, so that after the n-th pass the first n levels
+// are exact everywhere. A final pass recalculates ActiveCount from the first line, whose team sizes are up-to-date
+// by then. Statuses are re-evaluated afterwards by the status sweep.
+//
+// An account costs the same in every pass: its referrals are read once, whatever the size of its structure is.
+// Accounts are updated as usual meanwhile, data of the levels not rebuilt yet may be inconsistent until the passes
+// reach them.
+func (k Keeper) PerformNetworkRebuild(ctx sdk.Context) error {
+	if k.isPending(ctx, types.NetworkRebuildKey) {
+		depth := k.GetParams(ctx).NetworkDepth()
+		if err := k.sweep(ctx, types.NetworkRebuildKey, func(acc string) error {
+			return k.rebuildLevels(ctx, acc, depth)
+		}); err != nil {
+			return err
+		}
+		if k.isPending(ctx, types.NetworkRebuildKey) {
+			return nil
+		}
+		passes, err := k.getNetworkPasses(ctx)
+		if err != nil {
+			return err
+		}
+		if passes > 1 {
+			if err = k.setNetworkPasses(ctx, passes-1); err != nil {
+				return err
+			}
+			return k.startSweep(ctx, types.NetworkRebuildKey)
+		}
+		if err = k.storeService.OpenKVStore(ctx).Delete(types.NetworkPassesKey); err != nil {
+			return err
+		}
+		return k.startSweep(ctx, types.TeamRebuildKey)
+	}
+
+	if !k.isPending(ctx, types.TeamRebuildKey) {
+		return nil
+	}
+	if err := k.sweep(ctx, types.TeamRebuildKey, func(acc string) error {
+		return k.rebuildTeams(ctx, acc)
+	}); err != nil {
+		return err
+	}
+	if k.isPending(ctx, types.TeamRebuildKey) {
+		return nil
+	}
+	k.Logger(ctx).Info("Network rebuild done", "depth", k.GetParams(ctx).NetworkDepth())
+	return k.StartStatusSweep(ctx)
+}
+
+// getNetworkPasses returns the number of level passes left in the network rebuild, the current one inclusive.
+func (k Keeper) getNetworkPasses(ctx sdk.Context) (uint64, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.NetworkPassesKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+func (k Keeper) setNetworkPasses(ctx sdk.Context, passes uint64) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.NetworkPassesKey, sdk.Uint64ToBigEndian(passes))
+}
+
+// rebuildLevels recalculates LevelDelegated, ActiveRefCounts and TeamDelegated of the account.
+func (k Keeper) rebuildLevels(ctx sdk.Context, acc string, depth int) error {
+	info, err := k.Get(ctx, acc)
+	if err != nil {
+		return errors.Wrapf(err, "cannot obtain info for %s", acc)
+	}
+	levelDelegated, activeRefCounts, err := k.calcLevels(ctx, acc, info, depth)
+	if err != nil {
+		return err
+	}
+	teamDelegated := math.ZeroInt()
+	for _, delegated := range levelDelegated[1:] {
+		teamDelegated = teamDelegated.Add(delegated)
+	}
+	info.LevelDelegated = levelDelegated
+	info.ActiveRefCounts = activeRefCounts
+	info.TeamDelegated = &teamDelegated
+
+	return k.set(ctx, acc, info)
+}
+
+// calcLevels calculates LevelDelegated and ActiveRefCounts of the account from the ones of its first line: the n-th
+// level of the account is the sum of the (n-1)-th levels of its referrals. The result is exact as long as the
+// referrals' counters are.
+func (k Keeper) calcLevels(ctx sdk.Context, acc string, info types.Info, depth int) ([]math.Int, []uint64, error) {
+	levelDelegated := make([]math.Int, depth+1)
+	levelDelegated[0] = *info.SelfDelegated
+	for i := 1; i <= depth; i++ {
+		levelDelegated[i] = math.ZeroInt()
+	}
+	activeRefCounts := make([]uint64, depth+1)
+	if info.Active {
+		activeRefCounts[0] = 1
+	}
+
+	children, err := k.GetChildren(ctx, acc)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, child := range children {
+		childInfo, err := k.Get(ctx, child)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "cannot obtain info for %s", child)
+		}
+		childInfo.Normalize(depth)
+		for i := 1; i <= depth; i++ {
+			levelDelegated[i] = levelDelegated[i].Add(childInfo.LevelDelegated[i-1])
+			activeRefCounts[i] += childInfo.ActiveRefCounts[i-1]
+		}
+	}
+	return levelDelegated, activeRefCounts, nil
+}
+
+// rebuildTeams recalculates ActiveCount of the account.
+func (k Keeper) rebuildTeams(ctx sdk.Context, acc string) error {
+	info, err := k.Get(ctx, acc)
+	if err != nil {
+		return errors.Wrapf(err, "cannot obtain info for %s", acc)
+	}
+	if info.ActiveCount, err = k.calcTeams(ctx, acc); err != nil {
+		return err
+	}
+
+	return k.set(ctx, acc, info)
+}
+
+// calcTeams calculates ActiveCount of the account from its first line.
+func (k Keeper) calcTeams(ctx sdk.Context, acc string) (*types.ActiveAggregations, error) {
+	children, err := k.GetChildren(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.ActiveAggregations{}
+	for _, child := range children {
+		childInfo, err := k.Get(ctx, child)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot obtain info for %s", child)
+		}
+		if !childInfo.Active {
+			continue
+		}
+		result.FirstLine++
+		if childInfo.ActiveCount != nil && childInfo.ActiveCount.FirstLine >= StatusGuruMinXParameter {
+			result.FirstLineBy3++
+		}
+		changeTeamActive(result, childInfo.ActiveTeamSize(), 1)
+	}
+	return result, nil
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/util"
+	"github.com/axiome-pro/axm-node/x/referral"
+	"github.com/axiome-pro/axm-node/x/referral/keeper"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// beginBlock runs the referral begin blocker.
+func (s *KeeperTestSuite) beginBlock() {
+	s.Require().NoError(referral.NewAppModule(nil, *s.referralKeeper, nil, nil, nil).BeginBlock(s.ctx))
+}
+
+// rebuildPending tells whether the network aggregations invariant is skipped for a pending rebuild.
+func (s *KeeperTestSuite) rebuildPending() bool {
+	msg, broken := keeper.NetworkAggregationsInvariant(*s.referralKeeper)(s.ctx)
+	s.Require().False(broken, msg)
+	return strings.Contains(msg, "skipped")
+}
+
+func (s *KeeperTestSuite) TestNetworkRebuild() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+
+	// a starter needs 4000 axm in the structure, which A collects only from the deeper levels
+	params := k.GetParams(ctx)
+	params.StatusRequirements[0] = types.StatusRequirement{Status: types.STATUS_STARTER, StructureCoins: 4_000}
+	params.DelegatingAward.Network = []util.Fraction{util.Percent(5)}
+	k.SetParams(ctx, params)
+	s.buildTree()
+
+	threshold := params.ActivationThreshold
+	s.requireTeamDelegated(accA, threshold.MulRaw(3)) // C + F
+	require.Equal(types.STATUS_NEW, s.info(accA).Status)
+
+	params.DelegatingAward = types.DefaultDelegatingAward
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	require.NoError(err)
+	require.True(s.rebuildPending())
+
+	// a level pass per network level, then the team pass
+	depth := params.NetworkDepth()
+	for i := 0; i < depth; i++ {
+		require.True(s.rebuildPending(), "level pass #%d", i+1)
+		s.beginBlock()
+		require.Equal(types.STATUS_NEW, s.info(accA).Status)
+	}
+	require.True(s.rebuildPending(), "team pass")
+	s.beginBlock()
+	require.False(s.rebuildPending())
+	s.requireAggregationsMatch()
+
+	info := s.info(accA)
+	require.True(info.TeamDelegated.Equal(threshold.MulRaw(4).Add(threshold.QuoRaw(2))), info.TeamDelegated) // C + D + E + F
+	require.Len(info.LevelDelegated, depth+1)
+	require.True(info.LevelDelegated[1].Equal(threshold.MulRaw(3)), info.LevelDelegated[1])                // C + F
+	require.True(info.LevelDelegated[2].Equal(threshold.Add(threshold.QuoRaw(2))), info.LevelDelegated[2]) // D + E
+	require.Len(info.ActiveRefCounts, depth+1)
+	require.Equal([]uint64{0, 2, 1}, info.ActiveRefCounts[:3])
+	require.Equal(int32(2), info.ActiveCount.FirstLine)
+
+	// the status sweep following the rebuild promotes A
+	require.Equal(types.STATUS_STARTER, info.Status)
+
+	// the counters are kept up-to-date afterwards
+	require.NoError(k.OnBalanceChanged(ctx, accE, threshold.QuoRaw(2)))
+	s.requireTeamDelegated(accA, threshold.MulRaw(5))
+	require.Equal([]uint64{0, 2, 2}, s.info(accA).ActiveRefCounts[:3])
+	s.requireAggregationsMatch()
+}
+
+func (s *KeeperTestSuite) TestNetworkRebuildShallower() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+	s.buildTree()
+
+	params := k.GetParams(ctx)
+	params.DelegatingAward.Network = []util.Fraction{util.Percent(5)}
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	require.NoError(err)
+
+	for i := 0; i < 3 && s.rebuildPending(); i++ {
+		s.beginBlock()
+	}
+	require.False(s.rebuildPending())
+	s.requireAggregationsMatch()
+
+	threshold := params.ActivationThreshold
+	s.requireTeamDelegated(accA, threshold.MulRaw(3)) // C + F
+	s.requireTeamDelegated(accC, threshold.Add(threshold.QuoRaw(2)))
+	s.requireTeamDelegated(accD, math.ZeroInt())
+	require.Equal([]uint64{0, 2}, s.info(accA).ActiveRefCounts)
+}
+
+// expectDelegations makes the staking keeper report the accounts delegating the given coins to a single validator.
+// The map is read on every call, so the delegations can be changed later on.
+func (s *KeeperTestSuite) expectDelegations(stakes map[string]math.Int) {
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+	tokens := math.NewInt(1_000_000_000_000)
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Tokens:          tokens,
+		DelegatorShares: math.LegacyNewDecFromInt(tokens),
+	}
+	s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().IterateDelegatorDelegations(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, delegator sdk.AccAddress, cb func(stakingtypes.Delegation) bool) error {
+			if stake, ok := stakes[delegator.String()]; ok && stake.IsPositive() {
+				cb(stakingtypes.Delegation{
+					DelegatorAddress: delegator.String(),
+					ValidatorAddress: valAddr.String(),
+					Shares:           math.LegacyNewDecFromInt(stake),
+				})
+			}
+			return nil
+		},
+	).AnyTimes()
+}
+
+func (s *KeeperTestSuite) TestNetworkRepair() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+	s.buildTree()
+
+	threshold := k.GetParams(ctx).ActivationThreshold
+	stakes := map[string]math.Int{
+		accB: threshold,
+		accC: threshold.MulRaw(2),
+		accD: threshold,
+		accE: threshold.QuoRaw(2),
+		accF: threshold,
+	}
+	s.expectDelegations(stakes)
+	msg, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(broken, msg)
+
+	// D undelegates behind the hooks' back, the aggregations of A and C drift
+	stakes[accD] = threshold.QuoRaw(2)
+	info := s.info(accA)
+	teamDelegated := info.TeamDelegated.AddRaw(1)
+	info.TeamDelegated = &teamDelegated
+	info.ActiveRefCounts[2]++
+	require.NoError(k.SetInfo(ctx, accA, info))
+	info = s.info(accC)
+	info.ActiveCount.FirstLine = 5
+	require.NoError(k.SetInfo(ctx, accC, info))
+
+	msg, broken = keeper.NetworkAggregationsInvariant(*k)(ctx)
+	require.True(broken, msg)
+	msg, broken = keeper.SelfDelegatedInvariant(*k)(ctx)
+	require.True(broken, msg)
+
+	require.NoError(k.StartNetworkRepair(ctx))
+	msg, broken = keeper.AllInvariants(*k)(ctx)
+	require.False(broken, msg)
+	require.True(s.rebuildPending())
+
+	// the repair takes a block, then the rebuild follows
+	for i := 0; i < k.GetParams(ctx).NetworkDepth()+2 && s.rebuildPending(); i++ {
+		s.beginBlock()
+	}
+	require.False(s.rebuildPending())
+	msg, broken = keeper.AllInvariants(*k)(ctx)
+	require.False(broken, msg)
+
+	require.False(s.info(accD).Active)
+	s.requireTeamDelegated(accA, threshold.MulRaw(4)) // C + D + E + F
+	s.requireTeamDelegated(accC, threshold)
+	require.Equal([]uint64{0, 2, 0}, s.info(accA).ActiveRefCounts[:3])
+	require.Equal(int32(0), s.info(accC).ActiveCount.FirstLine)
+	require.Equal(int32(2), s.info(accA).ActiveCount.FirstLine)
+}
@@ -59,7 +59,7 @@ func (k Keeper) AddTopLevelAccount(ctx sdk.Context, acc string, status types.Sta
 
 	bu := newBunchUpdater(k, ctx)
 
-	newItem := types.NewInfoWithStatus("", math.ZeroInt(), status)
+	newItem := types.NewInfoWithStatus("", math.ZeroInt(), status, bu.getParams().NetworkDepth())
 	if err = bu.set(acc, newItem); err != nil {
 		return err
 	}
@@ -95,7 +95,7 @@ func (k Keeper) appendChild(ctx sdk.Context, parentAcc string, childAcc string,
 		anc       = parentAcc
 		delegated = math.ZeroInt()
 	)
-	newItem := types.NewInfoWithStatus(parentAcc, delegated, status)
+	newItem := types.NewInfoWithStatus(parentAcc, delegated, status, bu.getParams().NetworkDepth())
 
 	err := bu.set(childAcc, newItem)
 	if err != nil {
@@ -124,7 +124,7 @@ func (k Keeper) appendChild(ctx sdk.Context, parentAcc string, childAcc string,
 		return types.ErrRegistrationClosed
 	}
 
-	for i := 1; i < 14; i++ {
+	for i := 1; i < bu.getParams().NetworkDepth(); i++ {
 		if anc == "" {
 			break
 		}
@@ -178,3 +178,45 @@ func (k Keeper) GetChildren(ctx sdk.Context, acc string) (children []string, err
 
 	return children, nil
 }
+
+// countChildren returns the number of the account children without decoding them.
+func (k Keeper) countChildren(ctx sdk.Context, acc string) (uint32, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iteratorKey := types.GetReferralsChildIteratorKey(acc)
+
+	itr, err := store.Iterator(iteratorKey, storetypes.PrefixEndBytes(iteratorKey))
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	var count uint32
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// getChildAfter returns the child of the account following the given one in the store order, or the first child if
+// after is empty. It returns an empty string if there is no such child.
+func (k Keeper) getChildAfter(ctx sdk.Context, acc, after string) (string, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iteratorKey := types.GetReferralsChildIteratorKey(acc)
+	start := iteratorKey
+	if after != "" {
+		start = append(types.GetReferralsRelationKey(acc, after), 0x00)
+	}
+
+	itr, err := store.Iterator(start, storetypes.PrefixEndBytes(iteratorKey))
+	if err != nil {
+		return "", err
+	}
+	defer itr.Close()
+	if !itr.Valid() {
+		return "", nil
+	}
+	_, child, err := types.ParseReferralFromReleationKey(itr.Key())
+	return child, err
+}
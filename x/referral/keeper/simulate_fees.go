@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/util"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// SimulateDelegationFees reports what would happen with the delegated amount. The payouts are derived from the same
+// fee table PayUpFees uses, while PayUpFees itself runs against a branched context, so that the simulation fails
+// whenever the delegation would. Nothing is persisted.
+func (k Keeper) SimulateDelegationFees(ctx sdk.Context, acc string, amount math.Int) (*types.SimulateDelegationFeesResponse, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, _, err := k.GetReferralFeesForDelegating(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	remain, err := k.PayUpFees(cacheCtx, acc, amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot pay up fees")
+	}
+
+	result := &types.SimulateDelegationFeesResponse{
+		Payouts:  make([]types.ReferralPayout, 0, len(fees)),
+		UretMode: k.GetParams(ctx).UretMode,
+	}
+	denom := bondDenom
+	if result.UretMode {
+		denom = util.ConfigReferralDenom
+	}
+	paid := math.ZeroInt()
+	for _, fee := range fees {
+		x := feeAmount(fee.Ratio, amount)
+		if x == 0 {
+			continue
+		}
+		result.Payouts = append(result.Payouts, types.ReferralPayout{
+			Beneficiary: fee.Beneficiary,
+			Level:       fee.Level,
+			Amount:      sdk.NewInt64Coin(denom, x),
+		})
+		if denom == bondDenom {
+			paid = paid.AddRaw(x)
+		}
+	}
+
+	result.Remainder = sdk.NewCoin(bondDenom, remain)
+	result.Burned = sdk.NewCoin(bondDenom, amount.Sub(remain).Sub(paid))
+	return result, nil
+}
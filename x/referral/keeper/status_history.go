@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// GetStatusHistory returns the latest status changes of the account, the oldest first.
+func (k Keeper) GetStatusHistory(ctx sdk.Context, acc string) ([]types.StatusHistoryRecord, error) {
+	history, err := k.StatusHistory.Get(ctx, acc)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return history.Records, nil
+}
+
+// recordStatusChange appends a record to the account status history dropping the oldest ones beyond the limit.
+func (k Keeper) recordStatusChange(ctx sdk.Context, acc string, before, after types.Status, reason types.StatusChangeReason) error {
+	history, err := k.StatusHistory.Get(ctx, acc)
+	if errors.Is(err, collections.ErrNotFound) {
+		history = types.StatusHistory{}
+	} else if err != nil {
+		return err
+	}
+	history.Records = append(history.Records, types.StatusHistoryRecord{
+		Time:   ctx.BlockTime(),
+		Height: ctx.BlockHeight(),
+		Before: before,
+		After:  after,
+		Reason: reason,
+	})
+	if len(history.Records) > types.StatusHistoryLength {
+		history.Records = history.Records[len(history.Records)-types.StatusHistoryLength:]
+	}
+	return k.StatusHistory.Set(ctx, acc, history)
+}
+
+// ExportStatusHistory returns the status histories of all the accounts for genesis.
+func (k Keeper) ExportStatusHistory(ctx sdk.Context) ([]types.AccountStatusHistory, error) {
+	var result []types.AccountStatusHistory
+	err := k.StatusHistory.Walk(ctx, nil, func(acc string, history types.StatusHistory) (bool, error) {
+		result = append(result, types.AccountStatusHistory{
+			Account: acc,
+			Records: history.Records,
+		})
+		return false, nil
+	})
+	return result, err
+}
+
+// ImportStatusHistory restores the status histories exported by ExportStatusHistory.
+func (k Keeper) ImportStatusHistory(ctx sdk.Context, histories []types.AccountStatusHistory) error {
+	for _, h := range histories {
+		if err := k.StatusHistory.Set(ctx, h.Account, types.StatusHistory{Records: h.Records}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
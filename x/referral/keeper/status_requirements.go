@@ -8,56 +8,31 @@ import (
 	"github.com/axiome-pro/axm-node/x/referral/types"
 )
 
-func checkStatusRequirements(status types.Status, value types.Info) (types.StatusCheckResult, error) {
-	if status == types.STATUS_UNSPECIFIED {
+func checkStatusRequirements(params types.Params, status types.Status, value types.Info) (types.StatusCheckResult, error) {
+	if status == types.STATUS_UNSPECIFIED || status == types.STATUS_NEW {
 		return types.StatusCheckResult{Overall: true}, nil
 	}
-	return statusRequirements[status](value)
+	req, found := params.GetStatusRequirement(status)
+	if !found {
+		return types.StatusCheckResult{Overall: false}, fmt.Errorf("no requirements for status %s", status)
+	}
+	return statusRequirementsCheck(value, req)
 }
 
 const (
-	StatusGuruMinXCriteria = 3
-	StatusGuruMinXParameter
-	StatusLeaderMinXCriteria
+	StatusGuruMinXParameter = types.ReferralsSizeBy3
 )
 
-var statusRequirements = map[types.Status]func(value types.Info) (types.StatusCheckResult, error){
-	types.STATUS_NEW: func(_ types.Info) (types.StatusCheckResult, error) {
-		return types.StatusCheckResult{Overall: true}, nil
-	},
-	types.STATUS_STARTER: func(value types.Info) (types.StatusCheckResult, error) {
-		return statusRequirementsSelfStake(value, 1_000)
-	},
-	types.STATUS_LEADER: func(value types.Info) (types.StatusCheckResult, error) {
-		return statusRequirementsXByX(value, 10_000, 20_000, StatusLeaderMinXCriteria, 0)
-	},
-	types.STATUS_GURU: func(value types.Info) (types.StatusCheckResult, error) {
-		return statusRequirementsXByX(value, 25_000, 50_000, StatusGuruMinXCriteria, StatusGuruMinXParameter)
-	},
-	types.STATUS_BOSS: func(value types.Info) (types.StatusCheckResult, error) {
-		return statusRequirementsCore(value, 60_000, 150_000, 15)
-	},
-	types.STATUS_PRO: func(value types.Info) (types.StatusCheckResult, error) {
-		return statusRequirementsCore(value, 130_000, 300_000, 50)
-	},
-	types.STATUS_TOP: func(value types.Info) (types.StatusCheckResult, error) {
-		return statusRequirementsCore(value, 200_000, 800_000, 100)
-	},
-	types.STATUS_MEGA: func(value types.Info) (types.StatusCheckResult, error) {
-		return statusRequirementsCore(value, 350_000, 2_000_000, 300)
-	},
-}
-
-func statusRequirementsXByX(value types.Info, selfCoins, coins int64, count int, size int) (types.StatusCheckResult, error) {
+func statusRequirementsCheck(value types.Info, req types.StatusRequirement) (types.StatusCheckResult, error) {
 	var (
 		result    = types.StatusCheckResult{Overall: true}
 		criterion types.StatusCheckResult_Criterion
 	)
 
-	if coins > 0 {
+	if req.StructureCoins > 0 {
 		criterion = types.StatusCheckResult_Criterion{
 			Rule:        types.RULE_N_COINS_IN_STRUCTURE,
-			TargetValue: uint64(coins),
+			TargetValue: req.StructureCoins,
 			ActualValue: value.TeamDelegated.QuoRaw(1_000_000).Uint64(),
 		}
 		if criterion.ActualValue >= criterion.TargetValue {
@@ -68,10 +43,10 @@ func statusRequirementsXByX(value types.Info, selfCoins, coins int64, count int,
 		result.Overall = result.Overall && criterion.Met
 	}
 
-	if selfCoins > 0 {
+	if req.SelfStake > 0 {
 		criterion = types.StatusCheckResult_Criterion{
 			Rule:        types.RULE_SELF_STAKE,
-			TargetValue: uint64(selfCoins),
+			TargetValue: req.SelfStake,
 			ActualValue: value.SelfDelegated.QuoRaw(1_000_000).Uint64(),
 		}
 		if criterion.ActualValue >= criterion.TargetValue {
@@ -82,42 +57,16 @@ func statusRequirementsXByX(value types.Info, selfCoins, coins int64, count int,
 		result.Overall = result.Overall && criterion.Met
 	}
 
-	criterion = types.StatusCheckResult_Criterion{
-		Rule:        types.RULE_N_REFERRALS_WITH_X_REFERRALS_EACH,
-		TargetValue: uint64(count),
-		ParameterX:  uint64(size),
-	}
-
-	if size == 0 {
-		criterion.Met = value.ActiveCount.FirstLine >= int32(count)
-		criterion.ActualValue = uint64(value.ActiveCount.FirstLine)
-	} else if size == 3 {
-		criterion.Met = value.ActiveCount.FirstLineBy3 >= int32(count)
-		criterion.ActualValue = uint64(value.ActiveCount.FirstLineBy3)
-	} else {
-		return result, errors.New(fmt.Sprintf("statusRequirementsXByX incorrect size %d", size))
-	}
-
-	if criterion.ActualValue > criterion.TargetValue {
-		criterion.ActualValue = criterion.TargetValue
-	}
-
-	result.Criteria = append(result.Criteria, criterion)
-	result.Overall = result.Overall && criterion.Met
-	return result, nil
-}
-
-func statusRequirementsSelfStake(value types.Info, selfCoins int64) (types.StatusCheckResult, error) {
-	var (
-		result    = types.StatusCheckResult{Overall: true}
-		criterion types.StatusCheckResult_Criterion
-	)
-
-	if selfCoins > 0 {
+	if req.TeamsCount > 0 {
+		teams, err := activeTeamsOfAtLeast(value.ActiveCount, req.TeamsSize)
+		if err != nil {
+			return result, err
+		}
 		criterion = types.StatusCheckResult_Criterion{
-			Rule:        types.RULE_SELF_STAKE,
-			TargetValue: uint64(selfCoins),
-			ActualValue: value.SelfDelegated.QuoRaw(1_000_000).Uint64(),
+			Rule:        types.RULE_N_TEAMS_OF_X_PEOPLE_EACH,
+			TargetValue: uint64(req.TeamsCount),
+			ParameterX:  req.TeamsSize,
+			ActualValue: teams,
 		}
 		if criterion.ActualValue >= criterion.TargetValue {
 			criterion.Met = true
@@ -127,34 +76,20 @@ func statusRequirementsSelfStake(value types.Info, selfCoins int64) (types.Statu
 		result.Overall = result.Overall && criterion.Met
 	}
 
-	return result, nil
-}
-
-func statusRequirementsCore(value types.Info, selfCoins, coins int64, leg uint64) (types.StatusCheckResult, error) {
-	var (
-		result    = types.StatusCheckResult{Overall: true}
-		criterion types.StatusCheckResult_Criterion
-	)
-
-	if coins > 0 {
+	if req.ReferralsCount > 0 {
 		criterion = types.StatusCheckResult_Criterion{
-			Rule:        types.RULE_N_COINS_IN_STRUCTURE,
-			TargetValue: uint64(coins),
-			ActualValue: value.TeamDelegated.QuoRaw(1_000_000).Uint64(),
+			Rule:        types.RULE_N_REFERRALS_WITH_X_REFERRALS_EACH,
+			TargetValue: uint64(req.ReferralsCount),
+			ParameterX:  uint64(req.ReferralsSize),
 		}
-		if criterion.ActualValue >= criterion.TargetValue {
-			criterion.Met = true
-			criterion.ActualValue = criterion.TargetValue
-		}
-		result.Criteria = append(result.Criteria, criterion)
-		result.Overall = result.Overall && criterion.Met
-	}
 
-	if selfCoins > 0 {
-		criterion = types.StatusCheckResult_Criterion{
-			Rule:        types.RULE_SELF_STAKE,
-			TargetValue: uint64(selfCoins),
-			ActualValue: value.SelfDelegated.QuoRaw(1_000_000).Uint64(),
+		switch req.ReferralsSize {
+		case 0:
+			criterion.ActualValue = nonNegative(value.ActiveCount.FirstLine)
+		case types.ReferralsSizeBy3:
+			criterion.ActualValue = nonNegative(value.ActiveCount.FirstLineBy3)
+		default:
+			return result, errors.New(fmt.Sprintf("statusRequirementsCheck incorrect referrals size %d", req.ReferralsSize))
 		}
 		if criterion.ActualValue >= criterion.TargetValue {
 			criterion.Met = true
@@ -164,48 +99,28 @@ func statusRequirementsCore(value types.Info, selfCoins, coins int64, leg uint64
 		result.Overall = result.Overall && criterion.Met
 	}
 
-	criterion = types.StatusCheckResult_Criterion{
-		Rule:        types.RULE_N_TEAMS_OF_X_PEOPLE_EACH,
-		TargetValue: 3,
-		ParameterX:  leg,
-	}
-	xByX := types.StatusCheckResult_Criterion{
-		Rule:        types.RULE_N_REFERRALS_WITH_X_REFERRALS_EACH,
-		TargetValue: 3,
-		ParameterX:  3,
-	}
-
-	xByX.Met = value.ActiveCount.FirstLineBy3 >= 3
-	xByX.ActualValue = uint64(value.ActiveCount.FirstLineBy3)
-
-	if xByX.ActualValue > xByX.TargetValue {
-		xByX.ActualValue = xByX.TargetValue
-	}
-
-	var actualValue uint64 = 0
+	return result, nil
+}
 
-	switch leg {
+// activeTeamsOfAtLeast returns the number of active first line referrals leading a team of at least size people.
+func activeTeamsOfAtLeast(aag *types.ActiveAggregations, size uint64) (uint64, error) {
+	switch size {
 	case 15:
-		actualValue = uint64(value.ActiveCount.Team15 + value.ActiveCount.Team50 + value.ActiveCount.Team100 + value.ActiveCount.Team300)
+		return nonNegative(aag.Team15 + aag.Team50 + aag.Team100 + aag.Team300), nil
 	case 50:
-		actualValue = uint64(value.ActiveCount.Team50 + value.ActiveCount.Team100 + value.ActiveCount.Team300)
+		return nonNegative(aag.Team50 + aag.Team100 + aag.Team300), nil
 	case 100:
-		actualValue = uint64(value.ActiveCount.Team100 + value.ActiveCount.Team300)
+		return nonNegative(aag.Team100 + aag.Team300), nil
 	case 300:
-		actualValue = uint64(value.ActiveCount.Team300)
+		return nonNegative(aag.Team300), nil
 	default:
-		return result, errors.New(fmt.Sprintf("statusRequirementsCore incorrect leg %d", leg))
+		return 0, errors.New(fmt.Sprintf("activeTeamsOfAtLeast incorrect team size %d", size))
 	}
+}
 
-	criterion.Met = actualValue >= 3
-	if actualValue > 3 {
-		criterion.ActualValue = 3
-	} else {
-		criterion.ActualValue = actualValue
+func nonNegative(x int32) uint64 {
+	if x < 0 {
+		return 0
 	}
-
-	result.Criteria = append(result.Criteria, criterion, xByX)
-	result.Overall = result.Overall && criterion.Met && xByX.Met
-
-	return result, nil
+	return uint64(x)
 }
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/x/referral/keeper"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// legacyStatusFulfilled tells whether the info fulfills the status requirements as they were hardcoded before being
+// moved to params.
+func legacyStatusFulfilled(status types.Status, info types.Info) bool {
+	var (
+		self = info.SelfDelegated.QuoRaw(1_000_000).Uint64()
+		team = info.TeamDelegated.QuoRaw(1_000_000).Uint64()
+		aag  = info.ActiveCount
+	)
+	core := func(selfCoins, coins uint64, teams int32) bool {
+		return self >= selfCoins && team >= coins && teams >= 3 && aag.FirstLineBy3 >= 3
+	}
+	switch status {
+	case types.STATUS_NEW:
+		return true
+	case types.STATUS_STARTER:
+		return self >= 1_000
+	case types.STATUS_LEADER:
+		return self >= 10_000 && team >= 20_000 && aag.FirstLine >= 3
+	case types.STATUS_GURU:
+		return self >= 25_000 && team >= 50_000 && aag.FirstLineBy3 >= 3
+	case types.STATUS_BOSS:
+		return core(60_000, 150_000, aag.Team15+aag.Team50+aag.Team100+aag.Team300)
+	case types.STATUS_PRO:
+		return core(130_000, 300_000, aag.Team50+aag.Team100+aag.Team300)
+	case types.STATUS_TOP:
+		return core(200_000, 800_000, aag.Team100+aag.Team300)
+	case types.STATUS_MEGA:
+		return core(350_000, 2_000_000, aag.Team300)
+	default:
+		panic("unknown status")
+	}
+}
+
+func (s *KeeperTestSuite) info(acc string) types.Info {
+	info, err := s.referralKeeper.Get(s.ctx, acc)
+	s.Require().NoError(err)
+	return info
+}
+
+func (s *KeeperTestSuite) TestDefaultStatusRequirements() {
+	require := s.Require()
+	params := types.DefaultParams()
+
+	teams := []types.ActiveAggregations{
+		{},
+		{Team0: 3, Team15: 2},
+		{Team15: 3},
+		{Team15: 1, Team50: 1, Team300: 1},
+		{Team50: 2, Team100: 1},
+		{Team100: 3},
+		{Team300: 3},
+	}
+	for _, self := range []int64{0, 999, 1_000, 10_000, 25_000, 60_000, 130_000, 200_000, 350_000} {
+		for _, team := range []int64{0, 19_999, 20_000, 50_000, 150_000, 300_000, 800_000, 2_000_000} {
+			for _, firstLine := range []int32{2, 3} {
+				for _, firstLineBy3 := range []int32{0, 2, 3} {
+					for _, aag := range teams {
+						aag := aag
+						aag.FirstLine, aag.FirstLineBy3 = firstLine, firstLineBy3
+						selfDelegated, teamDelegated := math.NewInt(self*1_000_000), math.NewInt(team*1_000_000)
+						info := types.Info{SelfDelegated: &selfDelegated, TeamDelegated: &teamDelegated, ActiveCount: &aag}
+
+						for status := types.STATUS_NEW; status <= types.MaximumStatus; status++ {
+							result, err := keeper.CheckStatusRequirements(params, status, info)
+							require.NoError(err)
+							require.Equal(legacyStatusFulfilled(status, info), result.Overall,
+								"%s: self %d, team %d, aggregations %v", status, self, team, aag)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestStatusRequirementsUpdate() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+	s.buildTree()
+
+	// everyone reaching the threshold of 1000 axm is a starter
+	for _, acc := range []string{accB, accC, accD, accF} {
+		require.Equal(types.STATUS_STARTER, s.info(acc).Status, acc)
+	}
+	require.Equal(types.STATUS_NEW, s.info(accE).Status)
+
+	params := k.GetParams(ctx)
+	params.StatusRequirements[0] = types.StatusRequirement{Status: types.STATUS_STARTER, SelfStake: 1_500}
+	params.StatusRequirements[1] = types.StatusRequirement{Status: types.STATUS_LEADER, SelfStake: 2_000}
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	require.NoError(err)
+
+	// a status is checked against the table in force
+	result, err := k.AreStatusRequirementsFulfilled(ctx, accC, types.STATUS_LEADER)
+	require.NoError(err)
+	require.True(result.Overall)
+	require.Len(result.Criteria, 1)
+	require.Equal(types.RULE_SELF_STAKE, result.Criteria[0].Rule)
+	require.Equal(uint64(2_000), result.Criteria[0].TargetValue)
+
+	// statuses are not changed until the sweep reaches them
+	require.Equal(types.STATUS_STARTER, s.info(accC).Status)
+	require.NoError(k.PerformStatusSweep(ctx))
+
+	require.Equal(types.STATUS_LEADER, s.info(accC).Status)
+	for _, acc := range []string{accB, accD, accF} {
+		info := s.info(acc)
+		require.Equal(types.STATUS_STARTER, info.Status, acc)
+		require.NotNil(info.StatusDowngradeAt, acc)
+	}
+	require.Nil(s.info(accC).StatusDowngradeAt)
+	require.Equal(types.STATUS_NEW, s.info(accE).Status)
+}
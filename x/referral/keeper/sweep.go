@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// SweepBatchSize is the maximum number of accounts processed by a single sweep in one BeginBlock.
+const SweepBatchSize = 500
+
+// StartStatusSweep schedules re-evaluation of every account status against the current requirements. The sweep is
+// performed by BeginBlock in batches. If a sweep is already in progress, it starts over.
+func (k Keeper) StartStatusSweep(ctx sdk.Context) error {
+	k.Logger(ctx).Info("Status sweep scheduled")
+	return k.startSweep(ctx, types.StatusSweepKey)
+}
+
+// StartActivationSweep schedules activity check of every account against the current activation threshold. The
+// sweep is performed by BeginBlock in batches. If a sweep is already in progress, it starts over.
+func (k Keeper) StartActivationSweep(ctx sdk.Context) error {
+	k.Logger(ctx).Info("Activation sweep scheduled")
+	return k.startSweep(ctx, types.ActivationSweepKey)
+}
+
+// PerformStatusSweep re-evaluates the next batch of accounts if a status sweep is in progress.
+func (k Keeper) PerformStatusSweep(ctx sdk.Context) error {
+	return k.sweep(ctx, types.StatusSweepKey, func(acc string) error {
+		bu := newBunchUpdater(k, ctx)
+		if err := bu.update(acc, true, func(_ *types.Info) error { return nil }); err != nil {
+			return errors.Wrapf(err, "cannot re-evaluate status of %s", acc)
+		}
+		return bu.commit()
+	})
+}
+
+// PerformActivationSweep activates or deactivates the next batch of accounts if an activation sweep is in progress.
+func (k Keeper) PerformActivationSweep(ctx sdk.Context) error {
+	threshold := k.GetParams(ctx).ActivationThreshold
+	return k.sweep(ctx, types.ActivationSweepKey, func(acc string) error {
+		bu := newBunchUpdater(k, ctx)
+		info, err := bu.get(acc)
+		if err != nil {
+			return err
+		}
+		active := info.SelfDelegated.GTE(threshold)
+		if info.Active == active {
+			return nil
+		}
+		if err = k.SetActive(ctx, acc, active, true, bu); err != nil {
+			return errors.Wrapf(err, "cannot set active status of %s", acc)
+		}
+		return bu.commit()
+	})
+}
+
+func (k Keeper) startSweep(ctx sdk.Context, cursorKey []byte) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(cursorKey, types.InfoPrefix)
+}
+
+// sweep calls fn for the next batch of accounts starting from the cursor stored under cursorKey, then moves the
+// cursor forward. The cursor is removed as soon as all the accounts are processed.
+func (k Keeper) sweep(ctx sdk.Context, cursorKey []byte, fn func(acc string) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+	cursor, err := store.Get(cursorKey)
+	if err != nil {
+		return err
+	}
+	if cursor == nil {
+		return nil
+	}
+
+	itr, err := store.Iterator(cursor, storetypes.PrefixEndBytes(types.InfoPrefix))
+	if err != nil {
+		return err
+	}
+	accs := make([]string, 0, SweepBatchSize)
+	for ; itr.Valid() && len(accs) < SweepBatchSize; itr.Next() {
+		accs = append(accs, types.ParseInfoAddrKey(itr.Key()))
+	}
+	var next []byte
+	if itr.Valid() {
+		next = append([]byte(nil), itr.Key()...)
+	}
+	if err = itr.Close(); err != nil {
+		return err
+	}
+
+	for _, acc := range accs {
+		if err = fn(acc); err != nil {
+			return err
+		}
+	}
+
+	if next == nil {
+		k.Logger(ctx).Info("Sweep done", "cursor", cursorKey)
+		return store.Delete(cursorKey)
+	}
+	return store.Set(cursorKey, next)
+}
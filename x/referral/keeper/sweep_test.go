@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axiome-pro/axm-node/x/referral/keeper"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+func (s *KeeperTestSuite) TestActivationSweep() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+
+	// more accounts than a single batch takes
+	const total = keeper.SweepBatchSize + 100
+	threshold := k.GetParams(ctx).ActivationThreshold
+	accs := make([]string, total)
+	for i := range accs {
+		accs[i] = sdk.AccAddress([]byte(fmt.Sprintf("acc_%016d", i))).String()
+		require.NoError(k.AddTopLevelAccount(ctx, accs[i], types.STATUS_NEW))
+		require.NoError(k.OnBalanceChanged(ctx, accs[i], threshold))
+	}
+	activeCount := func() (count int) {
+		for _, acc := range accs {
+			if s.info(acc).Active {
+				count++
+			}
+		}
+		return count
+	}
+	require.Equal(total, activeCount())
+
+	params := k.GetParams(ctx)
+	params.ActivationThreshold = threshold.MulRaw(2)
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	require.NoError(err)
+
+	// the invariant waits for the sweep to finish
+	msg, broken := keeper.ActiveThresholdInvariant(*k)(ctx)
+	require.False(broken, msg)
+	require.True(strings.Contains(msg, "skipped"), msg)
+
+	// the first batch stops at the limit, the cursor is kept for the next block
+	require.NoError(k.PerformActivationSweep(ctx))
+	require.Equal(total-keeper.SweepBatchSize, activeCount())
+	msg, _ = keeper.ActiveThresholdInvariant(*k)(ctx)
+	require.True(strings.Contains(msg, "skipped"), msg)
+
+	// the next one resumes from the cursor and finishes the sweep
+	require.NoError(k.PerformActivationSweep(ctx))
+	require.Zero(activeCount())
+	msg, broken = keeper.ActiveThresholdInvariant(*k)(ctx)
+	require.False(broken, msg)
+	require.False(strings.Contains(msg, "skipped"), msg)
+
+	// stake changes are handled as usual once the sweep is over
+	require.NoError(k.OnBalanceChanged(ctx, accs[0], threshold))
+	require.NoError(k.PerformActivationSweep(ctx))
+	require.True(s.info(accs[0]).Active)
+	require.Equal(1, activeCount())
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/util"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+)
+
+// TransferReferral moves an account together with its structure under a new referrer. Network aggregations are
+// subtracted from the old ancestors and added to the new ones, then their statuses are checked. The caller is
+// responsible for the authorization, the old referrer is not consulted.
+func (k Keeper) TransferReferral(ctx sdk.Context, acc, newReferrer string) error {
+	if acc == newReferrer {
+		return errors.Wrap(types.ErrTransferCycle, "account cannot be its own referrer")
+	}
+	if !k.exists(ctx, acc) {
+		return errors.Wrap(types.ErrNotFound, acc)
+	}
+	if !k.exists(ctx, newReferrer) {
+		return errors.Wrap(types.ErrNotFound, newReferrer)
+	}
+
+	// the new referrer must not be a descendant of the account
+	for anc := newReferrer; anc != ""; {
+		if anc == acc {
+			return errors.Wrapf(types.ErrTransferCycle, "%s is a descendant of %s", newReferrer, acc)
+		}
+		var err error
+		if anc, err = k.GetParent(ctx, anc); err != nil {
+			return err
+		}
+	}
+
+	bu := newBunchUpdater(k, ctx)
+	info, err := bu.get(acc)
+	if err != nil {
+		return err
+	}
+	info.Normalize(bu.getParams().NetworkDepth())
+	oldReferrer := info.Referrer
+	if oldReferrer == newReferrer {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s is already the referrer of %s", newReferrer, acc)
+	}
+
+	if oldReferrer != "" {
+		if err = k.shiftStructure(bu, info, oldReferrer, -1); err != nil {
+			return errors.Wrap(err, "cannot detach from the old referrer")
+		}
+		store := k.storeService.OpenKVStore(ctx)
+		if err = store.Delete(types.GetReferralsRelationKey(oldReferrer, acc)); err != nil {
+			return err
+		}
+	}
+
+	if err = bu.update(acc, false, func(value *types.Info) error {
+		value.Referrer = newReferrer
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "cannot update "+acc)
+	}
+	if err = k.setBackRelation(ctx, newReferrer, acc); err != nil {
+		return errors.Wrap(err, "cannot set relation for "+newReferrer+" "+acc)
+	}
+
+	if err = k.shiftStructure(bu, info, newReferrer, 1); err != nil {
+		return errors.Wrap(err, "cannot attach to the new referrer")
+	}
+
+	if err = bu.commit(); err != nil {
+		return errors.Wrap(err, "cannot commit")
+	}
+
+	util.EmitEvent(ctx,
+		&types.EventReferralTransferred{
+			Address:     acc,
+			OldReferrer: oldReferrer,
+			NewReferrer: newReferrer,
+		},
+	)
+	return nil
+}
+
+// shiftStructure adds (sign = 1) or subtracts (sign = -1) network aggregations of the account structure to/from the
+// ancestor chain starting at parent. The structure is taken from the per-level counters of the account, so its
+// subtree is not walked.
+func (k Keeper) shiftStructure(bu *bunchUpdater, info types.Info, parent string, sign int64) error {
+	depth := bu.getParams().NetworkDepth()
+
+	// first line criteria of the parent
+	if info.Active {
+		if err := bu.update(parent, true, func(y *types.Info) error {
+			changeTeamActive(y.ActiveCount, info.ActiveTeamSize(), sign)
+			y.ActiveCount.FirstLine += int32(sign)
+			if info.ActiveCount.FirstLine >= StatusGuruMinXParameter {
+				y.ActiveCount.FirstLineBy3 += int32(sign)
+			}
+			if !y.Active || y.Referrer == "" {
+				return nil
+			}
+			// xby3 criteria for the parent's referrer
+			if (sign > 0 && y.ActiveCount.FirstLine == StatusGuruMinXParameter) ||
+				(sign < 0 && y.ActiveCount.FirstLine == StatusGuruMinXParameter-1) {
+				return bu.update(y.Referrer, true, func(z *types.Info) error {
+					z.ActiveCount.FirstLineBy3 += int32(sign)
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	// team delegations and active referrals of all the ancestors
+	anc := parent
+	for d := 1; d <= depth && anc != ""; d++ {
+		current := anc
+		if err := bu.update(current, true, func(x *types.Info) error {
+			oldTeamSize := x.ActiveTeamSize()
+			teamDelegated := *x.TeamDelegated
+			for j := 0; d+j <= depth; j++ {
+				x.ActiveRefCounts[d+j] = uint64(int64(x.ActiveRefCounts[d+j]) + sign*int64(info.ActiveRefCounts[j]))
+				delegated := info.LevelDelegated[j].MulRaw(sign)
+				x.LevelDelegated[d+j] = x.LevelDelegated[d+j].Add(delegated)
+				teamDelegated = teamDelegated.Add(delegated)
+			}
+			if !teamDelegated.Equal(*x.TeamDelegated) {
+				bu.addCallback(StakeChangedCallback, current)
+			}
+			x.TeamDelegated = &teamDelegated
+			newTeamSize := x.ActiveTeamSize()
+			anc = x.Referrer
+
+			if x.Active && anc != "" && oldTeamSize != newTeamSize {
+				return bu.update(anc, true, func(y *types.Info) error {
+					changeTeamActive(y.ActiveCount, oldTeamSize, -1)
+					changeTeamActive(y.ActiveCount, newTeamSize, 1)
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrapf(err, "cannot update ancestor %s (#%d)", current, d)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/x/referral/keeper"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	accA = sdk.AccAddress([]byte("acc_a_______________")).String()
+	accB = sdk.AccAddress([]byte("acc_b_______________")).String()
+	accC = sdk.AccAddress([]byte("acc_c_______________")).String()
+	accD = sdk.AccAddress([]byte("acc_d_______________")).String()
+	accE = sdk.AccAddress([]byte("acc_e_______________")).String()
+	accF = sdk.AccAddress([]byte("acc_f_______________")).String()
+	accX = sdk.AccAddress([]byte("acc_x_______________")).String()
+)
+
+// buildTree sets up the structure
+//
+//	A        B
+//	├── C
+//	│   ├── D
+//	│   └── E
+//	└── F
+//
+// where B, C, D and F are active, and E is not.
+func (s *KeeperTestSuite) buildTree() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+
+	require.NoError(k.AddTopLevelAccount(ctx, accA, types.STATUS_NEW))
+	require.NoError(k.AddTopLevelAccount(ctx, accB, types.STATUS_NEW))
+	require.NoError(k.AppendChild(ctx, accA, accC))
+	require.NoError(k.AppendChild(ctx, accC, accD))
+	require.NoError(k.AppendChild(ctx, accC, accE))
+	require.NoError(k.AppendChild(ctx, accA, accF))
+
+	threshold := k.GetParams(ctx).ActivationThreshold
+	for acc, stake := range map[string]math.Int{
+		accB: threshold,
+		accC: threshold.MulRaw(2),
+		accD: threshold,
+		accE: threshold.QuoRaw(2),
+		accF: threshold,
+	} {
+		require.NoError(k.OnBalanceChanged(ctx, acc, stake))
+	}
+	s.requireAggregationsMatch()
+}
+
+// requireAggregationsMatch checks the network aggregations against the ones recalculated from the tree.
+func (s *KeeperTestSuite) requireAggregationsMatch() {
+	msg, broken := keeper.NetworkAggregationsInvariant(*s.referralKeeper)(s.ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *KeeperTestSuite) requireTeamDelegated(acc string, expected math.Int) {
+	info, err := s.referralKeeper.Get(s.ctx, acc)
+	s.Require().NoError(err)
+	s.Require().True(info.TeamDelegated.Equal(expected), "%s: team delegated %s, expected %s", acc, info.TeamDelegated, expected)
+}
+
+func (s *KeeperTestSuite) TestTransferReferral() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+	s.buildTree()
+
+	threshold := k.GetParams(ctx).ActivationThreshold
+	structure := threshold.MulRaw(2).Add(threshold).Add(threshold.QuoRaw(2)) // C + D + E
+	s.requireTeamDelegated(accA, structure.Add(threshold))
+	s.requireTeamDelegated(accB, math.ZeroInt())
+
+	require.NoError(k.TransferReferral(ctx, accC, accB))
+
+	parent, err := k.GetParent(ctx, accC)
+	require.NoError(err)
+	require.Equal(accB, parent)
+	children, err := k.GetChildren(ctx, accA)
+	require.NoError(err)
+	require.Equal([]string{accF}, children)
+	children, err = k.GetChildren(ctx, accB)
+	require.NoError(err)
+	require.Equal([]string{accC}, children)
+
+	// the structure of C moves along
+	children, err = k.GetChildren(ctx, accC)
+	require.NoError(err)
+	require.ElementsMatch([]string{accD, accE}, children)
+
+	s.requireTeamDelegated(accA, threshold)
+	s.requireTeamDelegated(accB, structure)
+	s.requireTeamDelegated(accC, threshold.Add(threshold.QuoRaw(2)))
+	s.requireAggregationsMatch()
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		found = found || event.Type == "referral_transferred"
+	}
+	require.True(found)
+}
+
+func (s *KeeperTestSuite) TestTransferTopLevelReferral() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+	s.buildTree()
+
+	threshold := k.GetParams(ctx).ActivationThreshold
+	teamA := threshold.MulRaw(4).Add(threshold.QuoRaw(2)) // C + D + E + F
+
+	// a top level account has no old referrer to detach from
+	require.NoError(k.TransferReferral(ctx, accB, accF))
+
+	parent, err := k.GetParent(ctx, accB)
+	require.NoError(err)
+	require.Equal(accF, parent)
+	topLevel, err := k.GetTopLevelAccounts(ctx)
+	require.NoError(err)
+	require.Equal([]string{accA}, topLevel)
+
+	s.requireTeamDelegated(accF, threshold)
+	s.requireTeamDelegated(accA, teamA.Add(threshold))
+	s.requireAggregationsMatch()
+}
+
+func (s *KeeperTestSuite) TestTransferReferralErrors() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+	s.buildTree()
+
+	tests := []struct {
+		name        string
+		acc         string
+		newReferrer string
+		err         error
+	}{
+		{"own referrer", accC, accC, types.ErrTransferCycle},
+		{"under a referral", accC, accD, types.ErrTransferCycle},
+		{"under a deep referral", accA, accE, types.ErrTransferCycle},
+		{"unknown account", accX, accB, types.ErrNotFound},
+		{"unknown referrer", accC, accX, types.ErrNotFound},
+		{"same referrer", accC, accA, sdkerrors.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		require.ErrorIs(k.TransferReferral(ctx, tt.acc, tt.newReferrer), tt.err, tt.name)
+	}
+
+	// nothing is changed
+	parent, err := k.GetParent(ctx, accC)
+	require.NoError(err)
+	require.Equal(accA, parent)
+	s.requireAggregationsMatch()
+}
+
+func (s *KeeperTestSuite) TestMsgTransferReferralSigners() {
+	ctx, k := s.ctx, s.referralKeeper
+	require := s.Require()
+	s.buildTree()
+
+	tests := []struct {
+		name    string
+		signers []string
+		ok      bool
+	}{
+		{"no signers", nil, false},
+		{"referral alone", []string{accC}, false},
+		{"new referrer alone", []string{accB}, false},
+		{"old referrer instead of the referral", []string{accA, accB}, false},
+		{"old referrer and the referral", []string{accC, accA}, false},
+		{"both parties and the old referrer", []string{accC, accB, accA}, false},
+		{"both parties", []string{accC, accB}, true},
+		{"both parties reversed", []string{accB, accC}, true},
+		{"authority", []string{authority.String()}, true},
+	}
+	for _, tt := range tests {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := s.msgServer.TransferReferral(cacheCtx, &types.MsgTransferReferral{
+			Signers:            tt.signers,
+			ReferralAddress:    accC,
+			NewReferrerAddress: accB,
+		})
+		if tt.ok {
+			require.NoError(err, tt.name)
+			parent, err := k.GetParent(cacheCtx, accC)
+			require.NoError(err)
+			require.Equal(accB, parent, tt.name)
+		} else {
+			require.ErrorIs(err, types.ErrTransferSigners, tt.name)
+		}
+	}
+
+	_, err := s.msgServer.TransferReferral(ctx, &types.MsgTransferReferral{
+		Signers:            []string{accC, accB},
+		ReferralAddress:    "invalid",
+		NewReferrerAddress: accB,
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	// the message built by the CLI is authorized
+	msg := types.NewMsgTransferReferral(sdk.MustAccAddressFromBech32(accC), sdk.MustAccAddressFromBech32(accB))
+	_, err = s.msgServer.TransferReferral(ctx, msg)
+	require.NoError(err)
+}
@@ -13,6 +13,7 @@ import (
 // 1-second intervals or promotes accounts that now qualify for a higher status.
 func (k Keeper) UpgradeRecalculateStatuses(ctx sdk.Context) error {
 	logger := k.Logger(ctx)
+	params := k.GetParams(ctx)
 	logger.Info("Starting status recalculation for v2.2.0 upgrade ...")
 
 	var downgradeCounter int64
@@ -22,7 +23,7 @@ func (k Keeper) UpgradeRecalculateStatuses(ctx sdk.Context) error {
 			return false, false
 		}
 
-		checkResult, err := checkStatusRequirements(info.Status, *info)
+		checkResult, err := checkStatusRequirements(params, info.Status, *info)
 		if err != nil {
 			logger.Error("checkStatusRequirements failed", "acc", acc, "error", err)
 			return false, false
@@ -83,7 +84,7 @@ func (k Keeper) UpgradeRecalculateStatuses(ctx sdk.Context) error {
 			}
 			nextStatus++
 
-			cr, err := checkStatusRequirements(nextStatus, *info)
+			cr, err := checkStatusRequirements(params, nextStatus, *info)
 			if err != nil {
 				logger.Error("checkStatusRequirements for promotion failed", "acc", acc, "error", err)
 				nextStatus--
@@ -14,6 +14,7 @@ import (
 // are no longer met.
 func (k Keeper) UpgradeDeactivateBelowThreshold(ctx sdk.Context) error {
 	logger := k.Logger(ctx)
+	params := k.GetParams(ctx)
 	logger.Info("Starting activation threshold upgrade to 1000 axm ...")
 
 	// Phase 1: Deactivate accounts below new threshold
@@ -23,7 +24,7 @@ func (k Keeper) UpgradeDeactivateBelowThreshold(ctx sdk.Context) error {
 			return false, false
 		}
 		// New threshold: 1000 axm = 1_000_000_000 uaxm
-		if info.SelfDelegated.Int64() < 1_000_000_000 {
+		if info.SelfDelegated.LT(types.DefaultActivationThreshold) {
 			bu := newBunchUpdater(k, ctx)
 			err := k.SetActive(ctx, acc, false, false, bu)
 			if err != nil {
@@ -49,7 +50,7 @@ func (k Keeper) UpgradeDeactivateBelowThreshold(ctx sdk.Context) error {
 			return false, false
 		}
 
-		checkResult, err := checkStatusRequirements(info.Status, *info)
+		checkResult, err := checkStatusRequirements(params, info.Status, *info)
 		if err != nil {
 			logger.Error("checkStatusRequirements failed", "acc", acc, "error", err)
 			return false, false
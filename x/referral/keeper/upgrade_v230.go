@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeInitParamsV230 fills referral params introduced in v2.3.0 with their
+// default values, keeping the ones already set.
+func (k Keeper) UpgradeInitParamsV230(ctx sdk.Context) error {
+	logger := k.Logger(ctx)
+	logger.Info("Initializing referral params for v2.3.0 upgrade ...")
+
+	params := k.GetParams(ctx)
+	defaults := types.DefaultParams()
+
+	if len(params.StatusRequirements) == 0 {
+		params.StatusRequirements = defaults.StatusRequirements
+	}
+	if params.ActivationThreshold.IsNil() {
+		params.ActivationThreshold = defaults.ActivationThreshold
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	logger.Info("... params initialized")
+	return nil
+}
@@ -20,9 +20,11 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/axiome-pro/axm-node/x/referral/client/cli"
 	"github.com/axiome-pro/axm-node/x/referral/keeper"
+	"github.com/axiome-pro/axm-node/x/referral/simulation"
 	"github.com/axiome-pro/axm-node/x/referral/types"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -33,10 +35,11 @@ const ConsensusVersion = 1
 
 // TypeCode check to ensure the interface is properly implemented
 var (
-	_ appmodule.AppModule       = AppModule{}
-	_ appmodule.HasBeginBlocker = AppModule{}
-	_ module.HasGenesis         = AppModule{}
-	_ module.HasServices        = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasServices         = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
 
 	_ module.AppModuleBasic = AppModuleBasic{}
 )
@@ -135,7 +138,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the referral module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs genesis initialization for the referral module. It returns
 // no validator updates.
@@ -157,7 +162,40 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 // BeginBlock returns the begin blocker for the referral module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	return am.keeper.PerfomStatusDowngradeSchedule(sdk.UnwrapSDKContext(ctx))
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := am.keeper.PerfomStatusDowngradeSchedule(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.PerformNetworkRepair(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.PerformNetworkRebuild(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.PerformActivationSweep(sdkCtx); err != nil {
+		return err
+	}
+	return am.keeper.PerformStatusSweep(sdkCtx)
+}
+
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenState of the referral module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for referral module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.ModuleName] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the referral module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, simState.TxConfig,
+		am.accountKeeper, am.bankKeeper, am.stakingKeeper, am.keeper,
+	)
 }
 
 //
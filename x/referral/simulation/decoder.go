@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding referral type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.InfoPrefix):
+			var infoA, infoB types.Info
+			cdc.MustUnmarshal(kvA.Value, &infoA)
+			cdc.MustUnmarshal(kvB.Value, &infoB)
+			return fmt.Sprintf("%v\n%v\nfor %s", infoA, infoB, types.ParseInfoAddrKey(kvA.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.ReferralsPrefix):
+			referrer, referral, err := types.ParseReferralFromReleationKey(kvA.Key)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("relationA: %v\nrelationB: %v\nfor %s -> %s", kvA.Value, kvB.Value, referrer, referral)
+
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey.Bytes()):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.DowngradeQueuePrefix):
+			return fmt.Sprintf("downgradeA: %v\ndowngradeB: %v\nfor %s", kvA.Value, kvB.Value, types.ExtractAccFromDowngradeQueueKey(kvA.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.StatusSweepKey),
+			bytes.Equal(kvA.Key[:1], types.ActivationSweepKey),
+			bytes.Equal(kvA.Key[:1], types.NetworkRebuildKey),
+			bytes.Equal(kvA.Key[:1], types.TeamRebuildKey):
+			return fmt.Sprintf("cursorA: %s\ncursorB: %s", decodeCursor(kvA.Value), decodeCursor(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.EarningsPrefix.Bytes()),
+			bytes.Equal(kvA.Key[:1], types.SourceEarningsPrefix.Bytes()):
+			amountA, err := sdk.IntValue.Decode(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			amountB, err := sdk.IntValue.Decode(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("earningA: %s\nearningB: %s", amountA, amountB)
+
+		case bytes.Equal(kvA.Key[:1], types.StatusHistoryPrefix.Bytes()):
+			var historyA, historyB types.StatusHistory
+			cdc.MustUnmarshal(kvA.Value, &historyA)
+			cdc.MustUnmarshal(kvB.Value, &historyB)
+			return fmt.Sprintf("%v\n%v", historyA, historyB)
+
+		default:
+			panic(fmt.Sprintf("invalid referral key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
+
+// decodeCursor returns the account a sweep cursor points to. A bare Info prefix means the sweep starts over.
+func decodeCursor(bz []byte) string {
+	if len(bz) <= len(types.InfoPrefix) {
+		return "<start>"
+	}
+	return types.ParseInfoAddrKey(bz)
+}
@@ -0,0 +1,79 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/x/referral/simulation"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	accAddr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	accAddr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := testutil.MakeTestEncodingConfig().Codec
+	dec := simulation.NewDecodeStore(cdc)
+	now := time.Now().UTC()
+
+	params := types.DefaultParams()
+	info := types.NewInfoWithStatus(accAddr2, math.NewInt(1000), types.STATUS_LEADER, params.NetworkDepth())
+	history := types.StatusHistory{
+		Records: []types.StatusHistoryRecord{
+			{Time: now, Height: 10, Before: types.STATUS_NEW, After: types.STATUS_STARTER, Reason: types.STATUS_CHANGE_REASON_UPGRADE},
+		},
+	}
+	earning, err := sdk.IntValue.Encode(math.OneInt())
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetInfoAddrKey(accAddr1), Value: cdc.MustMarshal(&info)},
+			{Key: types.GetReferralsRelationKey(accAddr2, accAddr1), Value: []byte{0x01}},
+			{Key: types.ParamsKey.Bytes(), Value: cdc.MustMarshal(&params)},
+			{Key: types.GetDowngradeQueueKey(accAddr1, now), Value: []byte{0x01}},
+			{Key: types.StatusSweepKey, Value: types.GetInfoAddrKey(accAddr1)},
+			{Key: types.NetworkRebuildKey, Value: types.InfoPrefix},
+			{Key: append(types.EarningsPrefix.Bytes(), 0x01), Value: earning},
+			{Key: append(types.StatusHistoryPrefix.Bytes(), 0x01), Value: cdc.MustMarshal(&history)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Info", fmt.Sprintf("%v\n%v\nfor %s", info, info, accAddr1)},
+		{"Referrals", fmt.Sprintf("relationA: %v\nrelationB: %v\nfor %s -> %s", []byte{0x01}, []byte{0x01}, accAddr2, accAddr1)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"DowngradeQueue", fmt.Sprintf("downgradeA: %v\ndowngradeB: %v\nfor %s", []byte{0x01}, []byte{0x01}, accAddr1)},
+		{"StatusSweep", fmt.Sprintf("cursorA: %s\ncursorB: %s", accAddr1, accAddr1)},
+		{"NetworkRebuild", "cursorA: <start>\ncursorB: <start>"},
+		{"Earnings", "earningA: 1\nearningB: 1"},
+		{"StatusHistory", fmt.Sprintf("%v\n%v", history, history)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/util"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Simulation parameter constants
+const (
+	DelegatingAward       = "delegating_award"
+	StatusDowngradePeriod = "status_downgrade_period"
+	ActivationThreshold   = "activation_threshold"
+	TrackEarningSources   = "track_earning_sources"
+	TopLevelAccounts      = "top_level_accounts"
+	RegisteredFraction    = "registered_fraction"
+)
+
+// GenDelegatingAward randomized DelegatingAward. Every level pays at most 1%, so the total is always valid.
+func GenDelegatingAward(r *rand.Rand) types.NetworkAward {
+	network := make([]util.Fraction, simulation.RandIntBetween(r, 1, types.MaxNetworkDepth+1))
+	for i := range network {
+		network[i] = util.Permille(int64(r.Intn(11)))
+	}
+	return types.NetworkAward{Network: network}
+}
+
+// GenStatusDowngradePeriod randomized StatusDowngradePeriod
+func GenStatusDowngradePeriod(r *rand.Rand) int32 {
+	return int32(simulation.RandIntBetween(r, 60, int(types.DefaultStatusDowngradePeriod)+1))
+}
+
+// GenActivationThreshold randomized ActivationThreshold. It may exceed the initial stake, so that some of the
+// initially bonded accounts start inactive.
+func GenActivationThreshold(r *rand.Rand, initialStake math.Int) math.Int {
+	return simulation.RandomAmount(r, initialStake.MulRaw(2))
+}
+
+// GenTrackEarningSources randomized TrackEarningSources
+func GenTrackEarningSources(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenTopLevelAccounts randomized number of top level accounts
+func GenTopLevelAccounts(r *rand.Rand) int {
+	return simulation.RandIntBetween(r, 1, 4)
+}
+
+// GenRegisteredFraction randomized share (in percent) of the unbonded accounts registered at genesis. The rest is
+// left for MsgRegisterReferral operations.
+func GenRegisteredFraction(r *rand.Rand) int {
+	return r.Intn(101)
+}
+
+// GenStatus randomized account status, mostly the lowest ones.
+func GenStatus(r *rand.Rand) types.Status {
+	if r.Intn(3) != 0 {
+		return types.STATUS_NEW
+	}
+	return types.Status(simulation.RandIntBetween(r, int(types.STATUS_NEW), int(types.MaximumStatus)+1))
+}
+
+// RandomizedGenState generates a random GenesisState for referral
+func RandomizedGenState(simState *module.SimulationState) {
+	var delegatingAward types.NetworkAward
+	simState.AppParams.GetOrGenerate(DelegatingAward, &delegatingAward, simState.Rand, func(r *rand.Rand) { delegatingAward = GenDelegatingAward(r) })
+
+	var statusDowngradePeriod int32
+	simState.AppParams.GetOrGenerate(StatusDowngradePeriod, &statusDowngradePeriod, simState.Rand, func(r *rand.Rand) { statusDowngradePeriod = GenStatusDowngradePeriod(r) })
+
+	var activationThreshold math.Int
+	simState.AppParams.GetOrGenerate(ActivationThreshold, &activationThreshold, simState.Rand, func(r *rand.Rand) {
+		activationThreshold = GenActivationThreshold(r, simState.InitialStake)
+	})
+
+	var trackEarningSources bool
+	simState.AppParams.GetOrGenerate(TrackEarningSources, &trackEarningSources, simState.Rand, func(r *rand.Rand) { trackEarningSources = GenTrackEarningSources(r) })
+
+	var topLevelAccounts int
+	simState.AppParams.GetOrGenerate(TopLevelAccounts, &topLevelAccounts, simState.Rand, func(r *rand.Rand) { topLevelAccounts = GenTopLevelAccounts(r) })
+
+	var registeredFraction int
+	simState.AppParams.GetOrGenerate(RegisteredFraction, &registeredFraction, simState.Rand, func(r *rand.Rand) { registeredFraction = GenRegisteredFraction(r) })
+
+	params := types.DefaultParams()
+	params.DelegatingAward = delegatingAward
+	params.StatusDowngradePeriod = statusDowngradePeriod
+	params.ActivationThreshold = activationThreshold
+	params.TrackEarningSources = trackEarningSources
+
+	topLevel, other := RandomizedTree(simState, topLevelAccounts, registeredFraction)
+
+	referralGenesis := types.NewGenesisState(params, topLevel, other, nil, nil, nil, nil)
+
+	bz, err := json.MarshalIndent(&referralGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated referral parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(referralGenesis)
+}
+
+// RandomizedTree places simulation accounts into a random referral tree. The top level accounts and the initially
+// bonded accounts are always registered, since staking refuses delegations from unknown accounts; the others are
+// registered with the registeredFraction probability (in percent). Every account gets a random status.
+//
+// A referrer is always placed before its referrals, so it precedes them in the returned slices, as
+// ImportFromGenesis expects.
+func RandomizedTree(simState *module.SimulationState, topLevelAccounts, registeredFraction int) ([]*types.RefInfo, []types.Refs) {
+	var (
+		r        = simState.Rand
+		placed   []string
+		topLevel []*types.RefInfo
+		other    []types.Refs
+		children = make(map[string]int)
+	)
+	for i, acc := range simState.Accounts {
+		if len(placed) >= topLevelAccounts && i >= int(simState.NumBonded) && r.Intn(100) >= registeredFraction {
+			continue
+		}
+
+		addr := acc.Address.String()
+		info := types.NewRefInfo(addr, GenStatus(r))
+		if len(placed) < topLevelAccounts {
+			topLevel = append(topLevel, info)
+		} else {
+			referrer := placed[r.Intn(len(placed))]
+			idx, ok := children[referrer]
+			if !ok {
+				idx = len(other)
+				children[referrer] = idx
+				other = append(other, *types.NewRefs(referrer, nil))
+			}
+			other[idx].Referrals = append(other[idx].Referrals, info)
+		}
+		placed = append(placed, addr)
+	}
+	return topLevel, other
+}
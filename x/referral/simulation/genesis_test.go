@@ -0,0 +1,95 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/x/referral/simulation"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+// Abonormal scenarios are not tested here.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 10),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var referralGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &referralGenesis)
+
+	require.NoError(t, types.ValidateGenesis(referralGenesis))
+	require.NotEmpty(t, referralGenesis.TopLevelAccounts)
+	require.LessOrEqual(t, len(referralGenesis.TopLevelAccounts), 3)
+	require.Empty(t, referralGenesis.Downgrades)
+	require.Empty(t, referralGenesis.Earnings)
+	require.Empty(t, referralGenesis.SourceEarnings)
+	require.Empty(t, referralGenesis.StatusHistory)
+
+	// every referrer is registered before its referrals
+	registered := make(map[string]bool)
+	for _, info := range referralGenesis.TopLevelAccounts {
+		registered[info.Address] = true
+	}
+	for _, refs := range referralGenesis.OtherAccounts {
+		require.True(t, registered[refs.Referrer], refs.Referrer)
+		for _, info := range refs.Referrals {
+			require.False(t, registered[info.Address], info.Address)
+			registered[info.Address] = true
+		}
+	}
+	// the initially bonded accounts must be registered, or staking refuses their delegations
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		require.True(t, registered[acc.Address.String()], acc.Address.String())
+	}
+}
+
+// TestRandomizedGenState1 tests abnormal scenarios of applying RandomizedGenState.
+func TestRandomizedGenState1(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	// all these tests will panic
+	tests := []struct {
+		simState module.SimulationState
+		panicMsg string
+	}{
+		{ // panic => reason: incomplete initialization of the simState
+			module.SimulationState{}, "invalid memory address or nil pointer dereference"},
+		{ // panic => reason: incomplete initialization of the simState
+			module.SimulationState{
+				AppParams: make(simtypes.AppParams),
+				Cdc:       cdc,
+				Rand:      r,
+			}, "invalid memory address or nil pointer dereference"},
+	}
+
+	for _, tt := range tests {
+		require.Panicsf(t, func() { simulation.RandomizedGenState(&tt.simState) }, tt.panicMsg)
+	}
+}
@@ -0,0 +1,317 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/x/referral/keeper"
+	"github.com/axiome-pro/axm-node/x/referral/types"
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgRegisterReferral = "op_weight_msg_register_referral"
+	OpWeightMsgDelegate         = "op_weight_msg_delegate_across_threshold"
+	OpWeightMsgUndelegate       = "op_weight_msg_undelegate_across_threshold"
+
+	DefaultWeightMsgRegisterReferral = 100
+	DefaultWeightMsgDelegate         = 50
+	DefaultWeightMsgUndelegate       = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgRegisterReferral int
+		weightMsgDelegate         int
+		weightMsgUndelegate       int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgRegisterReferral, &weightMsgRegisterReferral, nil, func(_ *rand.Rand) {
+		weightMsgRegisterReferral = DefaultWeightMsgRegisterReferral
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgDelegate, &weightMsgDelegate, nil, func(_ *rand.Rand) {
+		weightMsgDelegate = DefaultWeightMsgDelegate
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgUndelegate, &weightMsgUndelegate, nil, func(_ *rand.Rand) {
+		weightMsgUndelegate = DefaultWeightMsgUndelegate
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgRegisterReferral,
+			SimulateMsgRegisterReferral(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegate,
+			SimulateMsgDelegate(txGen, ak, bk, sk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUndelegate,
+			SimulateMsgUndelegate(txGen, ak, bk, sk, k),
+		),
+	}
+}
+
+// SimulateMsgRegisterReferral generates a MsgRegisterReferral placing a random unregistered account under a random
+// registered one.
+func SimulateMsgRegisterReferral(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterReferral{})
+
+		var referral, referrer *simtypes.Account
+		for _, i := range r.Perm(len(accs)) {
+			info, err := k.Get(ctx, accs[i].Address.String())
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get referral info"), nil, err
+			}
+			if info.IsEmpty() {
+				if referral == nil {
+					referral = &accs[i]
+				}
+			} else if referrer == nil {
+				referrer = &accs[i]
+			}
+			if referral != nil && referrer != nil {
+				break
+			}
+		}
+		if referral == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "all accounts are registered"), nil, nil
+		}
+		if referrer == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no registered referrer"), nil, nil
+		}
+
+		msg := types.NewMsgRegisterReferral(referral.Address, referrer.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      *referral,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgDelegate generates a MsgDelegate from an inactive registered account, big enough to push its
+// self-delegated amount over the activation threshold.
+func SimulateMsgDelegate(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		info, err := k.Get(ctx, simAccount.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get referral info"), nil, err
+		}
+		if info.IsEmpty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is not registered"), nil, nil
+		}
+		if info.Active {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is already active"), nil, nil
+		}
+
+		denom, err := sk.BondDenom(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bond denom not found"), nil, err
+		}
+
+		vals, err := sk.GetAllValidators(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get validators"), nil, err
+		}
+		val, ok := testutil.RandSliceElem(r, vals)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pick a validator"), nil, nil
+		}
+		if val.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator's invalid echange rate"), nil, nil
+		}
+
+		// Referral fees are taken from the delegated coins, so twice the gap is delegated to stay above the
+		// threshold afterward.
+		gap := k.GetParams(ctx).ActivationThreshold.Sub(*info.SelfDelegated)
+		amount := gap.MulRaw(2)
+		if !amount.IsPositive() {
+			amount = math.OneInt()
+		}
+		balance := bk.GetBalance(ctx, simAccount.Address, denom).Amount
+		if balance.LT(amount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds to cross the threshold"), nil, nil
+		}
+		extra, err := simtypes.RandPositiveInt(r, balance.Sub(amount).AddRaw(1))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+		bondAmt := sdk.NewCoin(denom, amount.Add(extra).SubRaw(1))
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		var fees sdk.Coins
+
+		coins, hasNeg := spendable.SafeSub(bondAmt)
+		if !hasNeg {
+			fees, err = simtypes.RandomFees(r, ctx, coins)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+			}
+		}
+
+		msg := stakingtypes.NewMsgDelegate(simAccount.Address.String(), val.GetOperator(), bondAmt)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
+
+// SimulateMsgUndelegate generates a MsgUndelegate from an active registered account, big enough to drop its
+// self-delegated amount below the activation threshold when the delegation allows that.
+func SimulateMsgUndelegate(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		info, err := k.Get(ctx, simAccount.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get referral info"), nil, err
+		}
+		if !info.Active {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is not active"), nil, nil
+		}
+
+		delegations, err := sk.GetDelegatorDelegations(ctx, simAccount.Address, 10)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "error getting delegator delegations"), nil, err
+		}
+		delegation, ok := testutil.RandSliceElem(r, delegations)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account has no delegations"), nil, nil
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(delegation.GetValidatorAddr())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "error getting validator address bytes"), nil, err
+		}
+		val, err := sk.GetValidator(ctx, valAddr)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get validator"), nil, err
+		}
+
+		hasMaxUD, err := sk.HasMaxUnbondingDelegationEntries(ctx, simAccount.Address, valAddr)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "error getting max unbonding delegation entries"), nil, err
+		}
+		if hasMaxUD {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "keeper does have a max unbonding delegation entries"), nil, nil
+		}
+
+		totalBond := val.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "total bond is negative"), nil, nil
+		}
+
+		// The smallest amount that makes the account inactive, or the whole delegation if it is not enough.
+		unbondAmt := info.SelfDelegated.Sub(k.GetParams(ctx).ActivationThreshold).AddRaw(1)
+		if unbondAmt.GT(totalBond) || !unbondAmt.IsPositive() {
+			unbondAmt = totalBond
+		} else {
+			extra, err := simtypes.RandPositiveInt(r, totalBond.Sub(unbondAmt).AddRaw(1))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid unbond amount"), nil, err
+			}
+			unbondAmt = unbondAmt.Add(extra).SubRaw(1)
+		}
+
+		bondDenom, err := sk.BondDenom(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bond denom not found"), nil, err
+		}
+
+		msg := stakingtypes.NewMsgUndelegate(
+			simAccount.Address.String(), val.GetOperator(), sdk.NewCoin(bondDenom, unbondAmt),
+		)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -6,4 +6,6 @@ var (
 	ErrParentNil          = sdkerrors.Register(ModuleName, 1, "parentAcc cannot be nil")
 	ErrRegistrationClosed = sdkerrors.Register(ModuleName, 2, "referrer is inactive for too long")
 	ErrNotFound           = sdkerrors.Register(ModuleName, 3, "account is out of the referral structure")
+	ErrTransferCycle      = sdkerrors.Register(ModuleName, 4, "new referrer belongs to the transferred structure")
+	ErrTransferSigners    = sdkerrors.Register(ModuleName, 5, "transfer must be signed by the authority or by both parties")
 )
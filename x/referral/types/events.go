@@ -5,3 +5,5 @@ func (EventStatusUpdated) XXX_MessageName() string { return "status_updated" }
 func (EventStatusWillBeDowngraded) XXX_MessageName() string { return "status_will_be_downgraded" }
 
 func (EventStatusDowngradeCanceled) XXX_MessageName() string { return "status_downgrade_canceled" }
+
+func (EventReferralTransferred) XXX_MessageName() string { return "referral_transferred" }
@@ -7,6 +7,8 @@ import (
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
 )
 
 // ParamSubspace defines the expected Subspace interface
@@ -30,9 +32,20 @@ type BankKeeper interface {
 	InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
+
+	// used for simulations
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 // StakingKeeper expected staking keeper (noalias)
 type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
+	IterateDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, cb func(delegation stakingtypes.Delegation) (stop bool)) error
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+
+	// used for simulations
+	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
+	GetDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) ([]stakingtypes.Delegation, error)
+	HasMaxUnbondingDelegationEntries(ctx context.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (bool, error)
 }
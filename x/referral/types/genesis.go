@@ -44,12 +44,18 @@ func NewGenesisState(
 	topLevelAccounts []*RefInfo,
 	otherAccounts []Refs,
 	downgrades []Downgrade,
+	earnings []Earning,
+	sourceEarnings []SourceEarning,
+	statusHistory []AccountStatusHistory,
 ) *GenesisState {
 	return &GenesisState{
 		Params:           params,
 		TopLevelAccounts: topLevelAccounts,
 		OtherAccounts:    otherAccounts,
 		Downgrades:       downgrades,
+		Earnings:         earnings,
+		SourceEarnings:   sourceEarnings,
+		StatusHistory:    statusHistory,
 	}
 }
 
@@ -68,5 +74,60 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return errors.Wrap(err, "invalid params")
 	}
+	for _, e := range data.Earnings {
+		if err := sdk.ValidateDenom(e.Denom); err != nil {
+			return errors.Wrapf(err, "invalid earning of %s", e.Beneficiary)
+		}
+		if e.Amount.IsNil() || e.Amount.IsNegative() {
+			return errors.Errorf("invalid earning of %s: negative amount", e.Beneficiary)
+		}
+	}
+	for _, e := range data.SourceEarnings {
+		if err := sdk.ValidateDenom(e.Denom); err != nil {
+			return errors.Wrapf(err, "invalid source earning of %s", e.Beneficiary)
+		}
+		if e.Amount.IsNil() || e.Amount.IsNegative() {
+			return errors.Errorf("invalid source earning of %s: negative amount", e.Beneficiary)
+		}
+	}
+	accounts := make(map[string]bool, len(data.StatusHistory))
+	for i, h := range data.StatusHistory {
+		if err := h.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid status history (item #%d)", i)
+		}
+		if accounts[h.Account] {
+			return errors.Errorf("invalid status history (item #%d): duplicate account %s", i, h.Account)
+		}
+		accounts[h.Account] = true
+	}
+	return nil
+}
+
+// Validate checks the account address and that the records are well-formed, fit into StatusHistoryLength and go
+// in chronological order.
+func (h AccountStatusHistory) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Account); err != nil {
+		return errors.Wrap(err, "invalid account")
+	}
+	if len(h.Records) == 0 {
+		return errors.New("no records")
+	}
+	if len(h.Records) > StatusHistoryLength {
+		return errors.Errorf("too many records: %d > %d", len(h.Records), StatusHistoryLength)
+	}
+	for i, r := range h.Records {
+		if _, ok := Status_name[int32(r.Before)]; !ok {
+			return errors.Errorf("invalid record #%d: unknown status %d", i, r.Before)
+		}
+		if _, ok := Status_name[int32(r.After)]; !ok {
+			return errors.Errorf("invalid record #%d: unknown status %d", i, r.After)
+		}
+		if _, ok := StatusChangeReason_name[int32(r.Reason)]; !ok || r.Reason == STATUS_CHANGE_REASON_UNSPECIFIED {
+			return errors.Errorf("invalid record #%d: unknown reason %d", i, r.Reason)
+		}
+		if i > 0 && (r.Height < h.Records[i-1].Height || r.Time.Before(h.Records[i-1].Time)) {
+			return errors.Errorf("invalid record #%d: records must go in chronological order", i)
+		}
+	}
 	return nil
 }
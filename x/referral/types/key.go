@@ -32,11 +32,38 @@ const (
 // - 0x02<accAddrLen (1 Byte)><accAddr_Bytes><refAddrLen (1 Byte)><refAddr_Bytes>: Referral connection
 //
 // - 0x03: Params
+//
+// - 0x06: Status sweep cursor, the next Info key to re-evaluate
+//
+// - 0x07: Activation sweep cursor, the next Info key to check against the activation threshold
+//
+// - 0x08: Network rebuild cursor, the next Info key to recalculate levels of after the network depth has been changed
+//
+// - 0x09<beneficiary><denom><level>: Earnings per level
+//
+// - 0x0A<beneficiary><source><denom>: Earnings per source account
+//
+// - 0x0B<accAddr_Bytes>: StatusHistory
+//
+// - 0x0C: Team rebuild cursor, the next Info key to recalculate team aggregations of once the levels are rebuilt
+//
+// - 0x0D: Network rebuild passes, the number of level passes left including the current one
+//
+// - 0x0E: Network repair cursor, the next Info key to recalculate self delegation and activity of from staking
 var (
 	InfoPrefix           = []byte{0x00}
 	ReferralsPrefix      = []byte{0x01}
 	ParamsKey            = collections.NewPrefix(2)
 	DowngradeQueuePrefix = []byte{0x05}
+	StatusSweepKey       = []byte{0x06}
+	ActivationSweepKey   = []byte{0x07}
+	NetworkRebuildKey    = []byte{0x08}
+	EarningsPrefix       = collections.NewPrefix(9)
+	SourceEarningsPrefix = collections.NewPrefix(10)
+	StatusHistoryPrefix  = collections.NewPrefix(11)
+	TeamRebuildKey       = []byte{0x0C}
+	NetworkPassesKey     = []byte{0x0D}
+	NetworkRepairKey     = []byte{0x0E}
 )
 
 // GetInfoAddrKey creates the key for a referral info record.
@@ -4,6 +4,7 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 
 var (
 	_ sdk.Msg = (*MsgRegisterReferral)(nil)
+	_ sdk.Msg = (*MsgTransferReferral)(nil)
 )
 
 func NewMsgRegisterReferral(referral, referrer sdk.AccAddress) *MsgRegisterReferral {
@@ -12,3 +13,12 @@ func NewMsgRegisterReferral(referral, referrer sdk.AccAddress) *MsgRegisterRefer
 		ReferrerAddress: referrer.String(),
 	}
 }
+
+// NewMsgTransferReferral creates a transfer message signed by both the referral and the new referrer.
+func NewMsgTransferReferral(referral, newReferrer sdk.AccAddress) *MsgTransferReferral {
+	return &MsgTransferReferral{
+		Signers:            []string{referral.String(), newReferrer.String()},
+		ReferralAddress:    referral.String(),
+		NewReferrerAddress: newReferrer.String(),
+	}
+}
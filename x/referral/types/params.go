@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"gopkg.in/yaml.v3"
 
+	"cosmossdk.io/math"
+
 	"github.com/axiome-pro/axm-node/util"
 )
 
@@ -29,8 +31,30 @@ var (
 
 	// DefaultStatusDowngradePeriod 7 days
 	DefaultStatusDowngradePeriod int32 = 7 * 24 * 60 * 60
+
+	// MaxNetworkDepth limits the number of referral levels, so that hooks walking up the ancestors stay cheap.
+	MaxNetworkDepth = 32
+
+	// DefaultActivationThreshold 1000 axm
+	DefaultActivationThreshold = math.NewInt(1_000_000_000)
+
+	DefaultStatusRequirements = []StatusRequirement{
+		{Status: STATUS_STARTER, SelfStake: 1_000},
+		{Status: STATUS_LEADER, SelfStake: 10_000, StructureCoins: 20_000, ReferralsCount: 3, ReferralsSize: 0},
+		{Status: STATUS_GURU, SelfStake: 25_000, StructureCoins: 50_000, ReferralsCount: 3, ReferralsSize: 3},
+		{Status: STATUS_BOSS, SelfStake: 60_000, StructureCoins: 150_000, ReferralsCount: 3, ReferralsSize: 3, TeamsCount: 3, TeamsSize: 15},
+		{Status: STATUS_PRO, SelfStake: 130_000, StructureCoins: 300_000, ReferralsCount: 3, ReferralsSize: 3, TeamsCount: 3, TeamsSize: 50},
+		{Status: STATUS_TOP, SelfStake: 200_000, StructureCoins: 800_000, ReferralsCount: 3, ReferralsSize: 3, TeamsCount: 3, TeamsSize: 100},
+		{Status: STATUS_MEGA, SelfStake: 350_000, StructureCoins: 2_000_000, ReferralsCount: 3, ReferralsSize: 3, TeamsCount: 3, TeamsSize: 300},
+	}
 )
 
+// ReferralsSizeBy3 is the only non-zero size of first line referrals' own networks tracked by ActiveAggregations.
+const ReferralsSizeBy3 = 3
+
+// TeamSizeBuckets are team sizes tracked by ActiveAggregations.
+var TeamSizeBuckets = []uint64{15, 50, 100, 300}
+
 func (na NetworkAward) Validate() error { return validateNetworkAward(na) }
 
 func (p Params) String() string {
@@ -40,18 +64,71 @@ func (p Params) String() string {
 
 func (p Params) Validate() error {
 	if err := validateNetworkAward(p.DelegatingAward); err != nil {
-		return nil
+		return err
+	}
+	if err := validateStatusRequirements(p.StatusRequirements); err != nil {
+		return err
+	}
+	if err := validateActivationThreshold(p.ActivationThreshold); err != nil {
+		return err
 	}
 	// no validation needed for boolean UretMode
 	return nil
 }
 
+// GetStatusRequirement returns criteria for the status s.
+func (p Params) GetStatusRequirement(s Status) (StatusRequirement, bool) {
+	for _, r := range p.StatusRequirements {
+		if r.Status == s {
+			return r, true
+		}
+	}
+	return StatusRequirement{}, false
+}
+
+// NetworkDepth returns the number of referral levels, which is the length of the delegating award table.
+func (p Params) NetworkDepth() int {
+	return len(p.DelegatingAward.Network)
+}
+
+// LinesOpened returns the number of network levels an account with the status receives the delegating award from.
+// Every status above new opens two more levels, the maximum status opens the whole network.
+func (p Params) LinesOpened(s Status) int {
+	depth := p.NetworkDepth()
+	switch {
+	case s == STATUS_NEW:
+		return 0
+	case s > STATUS_NEW && s < MaximumStatus:
+		if lines := 2 * int(s-STATUS_NEW); lines < depth {
+			return lines
+		}
+		return depth
+	default:
+		return depth
+	}
+}
+
+// StatusRequirementsEqual reports whether the status requirements tables of both params are the same.
+func (p Params) StatusRequirementsEqual(other Params) bool {
+	if len(p.StatusRequirements) != len(other.StatusRequirements) {
+		return false
+	}
+	for i := range p.StatusRequirements {
+		if !p.StatusRequirements[i].Equal(&other.StatusRequirements[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
 	return Params{
 		DelegatingAward:       DefaultDelegatingAward,
 		StatusDowngradePeriod: DefaultStatusDowngradePeriod,
 		UretMode:              false,
+		StatusRequirements:    append([]StatusRequirement(nil), DefaultStatusRequirements...),
+		ActivationThreshold:   DefaultActivationThreshold,
 	}
 }
 
@@ -60,8 +137,11 @@ func validateNetworkAward(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if len(na.Network) == 0 || len(na.Network) > MaxNetworkDepth {
+		return fmt.Errorf("network award must have from 1 to %d levels (%d found)", MaxNetworkDepth, len(na.Network))
+	}
 	total := util.NewFraction(0, 1)
-	for i := 0; i < 14; i++ {
+	for i := range na.Network {
 		if na.Network[i].IsNegative() {
 			return fmt.Errorf("level %d award must be non-negative", i+1)
 		}
@@ -72,3 +152,45 @@ func validateNetworkAward(i interface{}) error {
 	}
 	return nil
 }
+
+func validateStatusRequirements(i interface{}) error {
+	reqs, ok := i.([]StatusRequirement)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(reqs) != int(MaximumStatus-STATUS_STARTER)+1 {
+		return fmt.Errorf("status requirements must have exactly %d items (%d found)", MaximumStatus-STATUS_STARTER+1, len(reqs))
+	}
+	for i, r := range reqs {
+		if r.Status != STATUS_STARTER+Status(i) {
+			return fmt.Errorf("status requirement #%d must be for %s, got %s", i, STATUS_STARTER+Status(i), r.Status)
+		}
+		if r.ReferralsSize != 0 && r.ReferralsSize != ReferralsSizeBy3 {
+			return fmt.Errorf("%s: referrals size must be either 0 or %d", r.Status, ReferralsSizeBy3)
+		}
+		if r.TeamsCount > 0 && !isTeamSizeBucket(r.TeamsSize) {
+			return fmt.Errorf("%s: teams size must be one of %v", r.Status, TeamSizeBuckets)
+		}
+	}
+	return nil
+}
+
+func validateActivationThreshold(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("activation threshold must be non-negative")
+	}
+	return nil
+}
+
+func isTeamSizeBucket(size uint64) bool {
+	for _, b := range TeamSizeBuckets {
+		if b == size {
+			return true
+		}
+	}
+	return false
+}
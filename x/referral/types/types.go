@@ -7,47 +7,24 @@ import (
 	"github.com/axiome-pro/axm-node/util"
 )
 
-func (s Status) LinesOpened() int {
-	switch s {
-	case STATUS_NEW:
-		return 0
-	case STATUS_STARTER:
-		return 2
-	case STATUS_LEADER:
-		return 4
-	case STATUS_GURU:
-		return 6
-	case STATUS_BOSS:
-		return 8
-	case STATUS_PRO:
-		return 10
-	case STATUS_TOP:
-		return 12
-	case STATUS_MEGA:
-		return 14
-	default:
-		return 14
-	}
-}
-
 const MinimumStatus = STATUS_NEW
 const MaximumStatus = STATUS_MEGA
 
-func NewInfo(referrer string, delegated math.Int) Info {
-	zero := math.ZeroInt()
-	return Info{
-		Status:          STATUS_NEW,
-		Referrer:        referrer,
-		Active:          false,
-		SelfDelegated:   &delegated,
-		TeamDelegated:   &zero,
-		ActiveCount:     &ActiveAggregations{},
-		ActiveRefCounts: make([]uint64, 15),
-	}
+// StatusHistoryLength is the maximum number of status changes kept per account.
+const StatusHistoryLength = 32
+
+// NewInfo creates an account info with ActiveRefCounts and LevelDelegated sized for the given network depth.
+func NewInfo(referrer string, delegated math.Int, depth int) Info {
+	return NewInfoWithStatus(referrer, delegated, STATUS_NEW, depth)
 }
 
-func NewInfoWithStatus(referrer string, delegated math.Int, status Status) Info {
+func NewInfoWithStatus(referrer string, delegated math.Int, status Status, depth int) Info {
 	zero := math.ZeroInt()
+	levelDelegated := make([]math.Int, depth+1)
+	levelDelegated[0] = delegated
+	for i := 1; i <= depth; i++ {
+		levelDelegated[i] = math.ZeroInt()
+	}
 	return Info{
 		Status:          status,
 		Referrer:        referrer,
@@ -55,7 +32,8 @@ func NewInfoWithStatus(referrer string, delegated math.Int, status Status) Info
 		SelfDelegated:   &delegated,
 		TeamDelegated:   &zero,
 		ActiveCount:     &ActiveAggregations{},
-		ActiveRefCounts: make([]uint64, 15),
+		ActiveRefCounts: make([]uint64, depth+1),
+		LevelDelegated:  levelDelegated,
 	}
 }
 
@@ -74,10 +52,17 @@ func (r Info) GetReferrer() sdk.AccAddress {
 	return addr
 }
 
-func (r *Info) Normalize() {
-	for len(r.ActiveRefCounts) < 15 {
+// Normalize resizes ActiveRefCounts and LevelDelegated to match the network depth: the account itself plus one
+// counter per level.
+func (r *Info) Normalize(depth int) {
+	for len(r.ActiveRefCounts) < depth+1 {
 		r.ActiveRefCounts = append(r.ActiveRefCounts, uint64(0))
 	}
+	r.ActiveRefCounts = r.ActiveRefCounts[:depth+1]
+	for len(r.LevelDelegated) < depth+1 {
+		r.LevelDelegated = append(r.LevelDelegated, math.ZeroInt())
+	}
+	r.LevelDelegated = r.LevelDelegated[:depth+1]
 }
 
 func (r Info) IsEmpty() bool {
@@ -91,9 +76,15 @@ func (r Info) GetActiveRefsCountFromLevelToLevel(from, to int) (sum uint64) {
 	return sum
 }
 
+// ActiveTeamSize returns the number of active accounts in the team, i.e. from the 1st to the next to last level.
+func (r Info) ActiveTeamSize() uint64 {
+	return r.GetActiveRefsCountFromLevelToLevel(1, len(r.ActiveRefCounts)-2)
+}
+
 type ReferralFee struct {
 	Beneficiary string        `json:"beneficiary" yaml:"beneficiary"`
 	Ratio       util.Fraction `json:"ratio" yaml:"ratio"`
+	Level       uint32        `json:"level" yaml:"level"`
 }
 
 func (fee ReferralFee) GetBeneficiary() sdk.AccAddress {
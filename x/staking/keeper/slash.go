@@ -174,11 +174,18 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
+	slashedValidator := validator
 	validator, err = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 	if err != nil {
 		return math.NewInt(0), err
 	}
 
+	// Delegator shares stay the same while the validator tokens decrease, so every
+	// delegation is now worth less. Report it to the referral hooks.
+	if err := k.refreshDelegationCoins(ctx, slashedValidator, validator); err != nil {
+		return math.NewInt(0), err
+	}
+
 	switch validator.GetStatus() {
 	case types.Bonded:
 		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
@@ -229,11 +236,54 @@ func (k Keeper) Unjail(ctx context.Context, consAddr sdk.ConsAddress) error {
 	return nil
 }
 
+// refreshDelegationCoins calls the DelegationCoinsModified referral hook for every
+// delegation whose coins value differs between the validator states before and after
+// the change of its tokens.
+//
+// NOTE the cost is linear in the number of delegations to the validator, each one
+// walking up the referral network, and it is paid by the block the slash happens in
+// (BeginBlock for downtime and double signs). It is not batched, since the referral
+// aggregates must match the delegations by the end of the block. A validator is
+// slashed at most once per infraction before being jailed (tombstoned for double
+// signs), and unbonded validators are never slashed, so this stays rare.
+func (k Keeper) refreshDelegationCoins(ctx context.Context, before, after types.Validator) error {
+	if before.Tokens.Equal(after.Tokens) {
+		return nil
+	}
+
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(after.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	delegations, err := k.GetValidatorDelegations(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		oldCoins := before.TokensFromSharesTruncated(delegation.Shares).TruncateInt()
+		newCoins := after.TokensFromSharesTruncated(delegation.Shares).TruncateInt()
+		if oldCoins.Equal(newCoins) {
+			continue
+		}
+
+		if err := k.RefHooks().DelegationCoinsModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress, oldCoins, newCoins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // slash an unbonding delegation and update the pool
 // return the amount that would have been slashed assuming
 // the unbonding delegation had enough stake to slash
 // (the amount actually slashed may be less if there's
 // insufficient stake remaining)
+//
+// NOTE unbonding coins have already been reported to the referral hooks as removed
+// by Unbond, so there is nothing to report here.
 func (k Keeper) SlashUnbondingDelegation(ctx context.Context, unbondingDelegation types.UnbondingDelegation,
 	infractionHeight int64, slashFactor math.LegacyDec,
 ) (totalSlashAmount math.Int, err error) {
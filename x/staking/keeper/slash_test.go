@@ -1,9 +1,15 @@
 package keeper_test
 
 import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
 	sdkmath "cosmossdk.io/math"
 
+	stakingkeeper "github.com/axiome-pro/axm-node/x/staking/keeper"
 	"github.com/axiome-pro/axm-node/x/staking/testutil"
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -50,3 +56,51 @@ func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	_, err := keeper.Slash(ctx, consAddr, 1, 10, fraction)
 	require.Error(err)
 }
+
+// tests that Slash reports decreased delegation coins to the referral hooks
+func (s *KeeperTestSuite) TestSlashRefHooks() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	halfShares := issuedShares.QuoInt64(2)
+	for _, delAddr := range delAddrs {
+		require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr.String(), valAddrs[0].String(), halfShares)))
+	}
+
+	refHooks := testutil.NewMockRefStakingHooks(gomock.NewController(s.T()))
+	keeper.SetRefHooks(refHooks)
+
+	reported := make(map[string]sdkmath.Int)
+	refHooks.EXPECT().
+		DelegationCoinsModified(gomock.Any(), gomock.Any(), valAddrs[0].String(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delAddr, _ string, oldCoins, newCoins sdkmath.Int) error {
+			require.True(oldCoins.Equal(startTokens.QuoRaw(2)))
+			reported[delAddr] = newCoins
+			return nil
+		}).
+		Times(len(delAddrs))
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.BondedPoolName, gomock.Any())
+
+	fraction := sdkmath.LegacyNewDecWithPrec(5, 1)
+	burned, err := keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
+	require.NoError(err)
+	require.True(burned.Equal(startTokens.QuoRaw(2)))
+
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	for _, delAddr := range delAddrs {
+		expected := validator.TokensFromSharesTruncated(halfShares).TruncateInt()
+		require.True(reported[delAddr.String()].Equal(expected))
+	}
+}
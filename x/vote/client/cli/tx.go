@@ -18,6 +18,11 @@ import (
 	"github.com/axiome-pro/axm-node/x/vote/types"
 )
 
+const (
+	FlagOption    = "option"
+	FlagWeighting = "weighting"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func NewTxCmd() *cobra.Command {
 	voteTxCmd := &cobra.Command{
@@ -31,6 +36,9 @@ func NewTxCmd() *cobra.Command {
 	voteTxCmd.AddCommand(
 		NewCmdSubmitProposal(),
 		cmdVote(),
+		cmdVeto(),
+		cmdCancelProposal(),
+		NewCmdAmendProposal(),
 		cmdStartPoll(),
 		cmdAnswerPoll(),
 	)
@@ -40,11 +48,11 @@ func NewTxCmd() *cobra.Command {
 
 func cmdVote() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "vote agree|disagree <voter_key_or_address>",
-		Short: "Vote for/against the current proposal",
-		Args:  cobra.ExactArgs(2),
+		Use:   "vote <proposal_id> agree|disagree <voter_key_or_address>",
+		Short: "Vote for/against an active proposal, or change the vote given before",
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
 				return err
 			}
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -52,15 +60,89 @@ func cmdVote() *cobra.Command {
 				return err
 			}
 
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse proposal id")
+			}
+
 			voter := clientCtx.GetFromAddress().String()
-			agree := strings.ToLower(args[0]) == "agree"
-			if !agree && strings.ToLower(args[0]) != "disagree" {
+			agree := strings.ToLower(args[1]) == "agree"
+			if !agree && strings.ToLower(args[1]) != "disagree" {
 				return errors.New("cannot parse aggree/disagree flag")
 			}
 
 			msg := &types.MsgVote{
-				Voter: voter,
-				Agree: agree,
+				Voter:      voter,
+				Agree:      agree,
+				ProposalId: id,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdVeto() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto <proposal_id> <governor_key_or_address>",
+		Short: "Veto an approved proposal waiting for execution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse proposal id")
+			}
+
+			msg := &types.MsgVeto{
+				Governor:   clientCtx.GetFromAddress().String(),
+				ProposalId: id,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal <proposal_id> <author_key_or_address>",
+		Short: "Withdraw an own proposal nobody else has voted for yet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse proposal id")
+			}
+
+			msg := &types.MsgCancelProposal{
+				Author:     clientCtx.GetFromAddress().String(),
+				ProposalId: id,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -77,9 +159,10 @@ func cmdStartPoll() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "start-poll <author_key_or_address> validators|status:<status> <name> <text> [quorum]",
 		Aliases: []string{"start_poll", "sp"},
-		Short:   "Start a public poll",
-		Example: `start-poll ivan validators Halving "Should we decrease all awards by a half next Monday?" 2/3`,
+		Short:   "Start a public poll, or queue it while another one is active",
 		Args:    cobra.RangeArgs(4, 5),
+		Example: `start-poll ivan validators Halving "Should we decrease all awards by a half next Monday?" 2/3
+start-poll ivan status:3 Halving "When should we decrease all awards by a half?" 1/2 --option Monday --option Friday --option Never`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
 				return err
@@ -130,7 +213,22 @@ func cmdStartPoll() *cobra.Command {
 				}
 			}
 
-			msg := types.MsgStartPoll{Poll: poll}
+			if poll.Options, err = cmd.Flags().GetStringArray(FlagOption); err != nil {
+				return err
+			}
+
+			weighting, err := cmd.Flags().GetString(FlagWeighting)
+			if err != nil {
+				return err
+			}
+			name := "POLL_WEIGHTING_" + strings.ReplaceAll(strings.ToUpper(weighting), "-", "_")
+			if w, ok := types.PollWeighting_value[name]; !ok {
+				return errors.New("cannot parse weighting")
+			} else {
+				poll.Weighting = types.PollWeighting(w)
+			}
+
+			msg := types.MsgStartPoll{Poll: poll, Author: poll.Author}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -138,15 +236,17 @@ func cmdStartPoll() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringArray(FlagOption, nil, "Option of a multiple-choice poll (repeat for every option)")
+	cmd.Flags().String(FlagWeighting, "account", "How much an answer counts: account|self-delegated|team-delegated|voting-power")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
 func cmdAnswerPoll() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "answer yes|no <respondent_key_or_address>",
+		Use:     "answer yes|no|<option_index> <respondent_key_or_address>",
 		Aliases: []string{"ans", "a", "answer-poll", "answer_poll"},
-		Short:   "Answer the current public poll",
+		Short:   "Answer the current public poll, with the option index if it is a multiple-choice one",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
@@ -157,16 +257,22 @@ func cmdAnswerPoll() *cobra.Command {
 				return err
 			}
 
-			var yes bool
+			var (
+				yes    bool
+				option uint64
+			)
 			if ans := strings.ToLower(args[0]); ans == "yes" {
 				yes = true
 			} else if ans != "no" {
-				return errors.New("cannot parse answer")
+				if option, err = strconv.ParseUint(ans, 10, 32); err != nil {
+					return errors.New("cannot parse answer")
+				}
 			}
 
 			msg := types.MsgAnswerPoll{
 				Respondent: clientCtx.GetFromAddress().String(),
 				Yes:        yes,
+				Option:     uint32(option),
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -235,3 +341,47 @@ Where proposal.json contains:
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdAmendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-proposal <proposal_id> [path/to/proposal.json]",
+		Short: "Replace the messages of an own proposal, discarding the votes given so far",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the messages of an own proposal, discarding the votes given so far. The voting period starts over.
+The new messages and (optionally) name are defined in a JSON file of the same format submit-proposal takes.
+
+Example:
+$ %s tx vote amend-proposal 4 path/to/proposal.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse proposal id")
+			}
+
+			proposal, msgs, err := parseSubmitProposal(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgAmendProposal(msgs, clientCtx.GetFromAddress().String(), id, proposal.Name)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -15,15 +15,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	k.Logger(ctx).Info("Starting from genesis...")
 	k.SetParams(ctx, data.Params)
 	k.SetGovernment(ctx, types.Government{Members: data.Government})
-	if len(data.CurrentProposal.Messages) > 0 {
-		k.SetCurrentProposal(ctx, data.CurrentProposal)
-		k.SetStartBlock(ctx.WithBlockHeight(data.StartBlock))
+	for _, p := range data.Proposals {
+		k.SetProposal(ctx, p.Proposal)
+		k.SetAgreed(ctx, p.Proposal.Id, p.GetAgreed())
+		k.SetDisagreed(ctx, p.Proposal.Id, p.GetDisagreed())
 	}
-	if len(data.Agreed) > 0 {
-		k.SetAgreed(ctx, types.Government{Members: data.Agreed})
-	}
-	if len(data.Disagreed) > 0 {
-		k.SetDisagreed(ctx, types.Government{Members: data.Disagreed})
+	k.SetNextProposalID(ctx, data.NextProposalId)
+	for _, queued := range data.Queue {
+		k.SetQueuedProposal(ctx, queued)
 	}
 	for _, record := range data.History {
 		k.AddProposalHistoryRecord(ctx, record)
@@ -35,29 +34,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (data *types.GenesisState) {
-	var currentProposal *types.Proposal
-	var startBlock int64
-	if currentProposal = k.GetCurrentProposal(ctx); currentProposal != nil {
-		startBlock = k.GetStartBlock(ctx)
-	}
 	data = types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetGovernment(ctx),
-		currentProposal,
-		startBlock,
-		k.GetAgreed(ctx),
-		k.GetDisagreed(ctx),
-		k.GetHistory(ctx, math.MaxInt32, 1),
+		k.GetActiveProposals(ctx),
+		k.GetNextProposalID(ctx),
+		k.GetQueue(ctx),
+		k.GetHistory(ctx, math.MaxInt32, 1, types.PROPOSAL_STATUS_UNSPECIFIED),
 	)
 	if poll, ok := k.GetCurrentPoll(ctx); ok {
 		data.CurrentPoll = &poll
-		if err := k.IterateThroughCurrentPollAnswers(ctx, func(acc string, ans bool) (stop bool) {
-			data.PollAnswers = append(data.PollAnswers, types.PollAnswer{Acc: acc, Ans: ans})
+		if err := k.IterateThroughCurrentPollAnswers(ctx, func(ans types.PollAnswer) (stop bool) {
+			data.PollAnswers = append(data.PollAnswers, ans)
 			return false
 		}); err != nil {
 			panic(err)
 		}
 	}
+	data.PollQueue = k.GetPollQueue(ctx)
 	data.PollHistory = k.GetPollHistoryAll(ctx)
 	return data
 }
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,23 +14,52 @@ func (k Keeper) BeginBlock(ctx sdk.Context) error {
 		}
 	}()
 
-	proposal := k.GetCurrentProposal(ctx)
-	if proposal != nil {
-		if proposal.EndTime.Before(ctx.BlockTime()) {
-			_, agree := k.Validate(
-				k.GetGovernment(ctx),
-				k.GetAgreed(ctx),
-				k.GetDisagreed(ctx),
-			)
+	var expired []uint64
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndExclusive(collections.Join(ctx.BlockTime(), uint64(0)))
+	if err := k.ProposalQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		expired = append(expired, key.K2())
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	for _, id := range expired {
+		proposal, ok := k.GetProposal(ctx, id)
+		if !ok {
+			continue
+		}
+		_, agree := k.Validate(
+			ctx,
+			proposal,
+			k.GetGovernment(ctx),
+			k.GetAgreed(ctx, id),
+			k.GetDisagreed(ctx, id),
+		)
+
+		k.EndProposal(ctx, proposal, agree)
+	}
+
+	var due []uint64
+	if err := k.ExecutionQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		due = append(due, key.K2())
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
 
-			k.EndProposal(ctx, *proposal, agree)
+	for _, id := range due {
+		if queued, ok := k.GetQueuedProposal(ctx, id); ok {
+			k.ExecuteQueuedProposal(ctx, queued)
 		}
 	}
 
 	poll, ok := k.GetCurrentPoll(ctx)
 	if ok {
 		if poll.EndTime.Before(ctx.BlockTime()) {
-			k.EndPoll(ctx)
+			if err := k.EndPoll(ctx); err != nil {
+				k.Logger(ctx).Error("cannot end poll", "name", poll.Name, "err", err)
+			}
 		}
 	}
 
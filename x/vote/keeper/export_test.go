@@ -0,0 +1,3 @@
+package keeper
+
+var LeadingTally = leadingTally
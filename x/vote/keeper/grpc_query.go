@@ -33,7 +33,7 @@ func (qs QueryServer) History(ctx context.Context, req *types.HistoryRequest) (*
 		page = 1
 	}
 
-	data := k.GetHistory(sdkCtx, limit, page)
+	data := k.GetHistory(sdkCtx, limit, page, req.Status)
 	return &types.HistoryResponse{
 		History: data,
 	}, nil
@@ -50,29 +50,28 @@ func (qs QueryServer) Government(ctx context.Context, _ *types.GovernmentRequest
 	}, nil
 }
 
-func (qs QueryServer) Current(ctx context.Context, _ *types.CurrentRequest) (*types.CurrentResponse, error) {
+func (qs QueryServer) Current(ctx context.Context, req *types.CurrentRequest) (*types.CurrentResponse, error) {
 	var (
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = qs.Keeper
 	)
-	var (
-		proposal = k.GetCurrentProposal(sdkCtx)
-		gov,
-		agreed,
-		disagreed types.Government
-	)
-	if proposal == nil {
-		proposal = new(types.Proposal)
+	var proposals []types.ActiveProposal
+	if req.Id != 0 {
+		proposal, ok := k.GetProposal(sdkCtx, req.Id)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "There is no active proposal with id %d", req.Id)
+		}
+		proposals = []types.ActiveProposal{{
+			Proposal:  proposal,
+			Agreed:    k.GetAgreed(sdkCtx, req.Id).Members,
+			Disagreed: k.GetDisagreed(sdkCtx, req.Id).Members,
+		}}
 	} else {
-		gov = k.GetGovernment(sdkCtx)
-		agreed = k.GetAgreed(sdkCtx)
-		disagreed = k.GetDisagreed(sdkCtx)
+		proposals = k.GetActiveProposals(sdkCtx)
 	}
 	return &types.CurrentResponse{
-		Proposal:   *proposal,
-		Government: gov.Strings(),
-		Agreed:     agreed.Strings(),
-		Disagreed:  disagreed.Strings(),
+		Government: k.GetGovernment(sdkCtx).Strings(),
+		Proposals:  proposals,
 	}, nil
 }
 
@@ -97,14 +96,28 @@ func (qs QueryServer) Poll(ctx context.Context, _ *types.PollRequest) (*types.Po
 		return nil, status.Error(codes.NotFound, "There is no active poll at the moment")
 	}
 	yes, no := k.GetPollStatus(sdkCtx)
+	weightedYes, weightedNo, weightedTallies := k.GetPollWeights(sdkCtx, poll)
 
 	return &types.PollResponse{
-		Poll: poll,
-		Yes:  yes,
-		No:   no,
+		Poll:    poll,
+		Yes:     yes,
+		No:      no,
+		Tallies: k.GetPollTallies(sdkCtx, poll),
+
+		WeightedYes:     weightedYes,
+		WeightedNo:      weightedNo,
+		WeightedTallies: weightedTallies,
 	}, nil
 }
 
+func (qs QueryServer) PollQueue(ctx context.Context, _ *types.PollQueueRequest) (*types.PollQueueResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = qs.Keeper
+	)
+	return &types.PollQueueResponse{Queue: k.GetPollQueue(sdkCtx)}, nil
+}
+
 func (qs QueryServer) PollHistory(ctx context.Context, req *types.PollHistoryRequest) (*types.PollHistoryResponse, error) {
 	var (
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
@@ -113,3 +126,41 @@ func (qs QueryServer) PollHistory(ctx context.Context, req *types.PollHistoryReq
 	data := k.GetPollHistory(sdkCtx, req.Limit, req.Page)
 	return &types.PollHistoryResponse{History: data}, nil
 }
+
+func (qs QueryServer) Queue(ctx context.Context, _ *types.QueueRequest) (*types.QueueResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = qs.Keeper
+	)
+	return &types.QueueResponse{Queue: k.GetQueue(sdkCtx)}, nil
+}
+
+func (qs QueryServer) SimulateProposal(ctx context.Context, req *types.SimulateProposalRequest) (*types.SimulateProposalResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = qs.Keeper
+	)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	messages := req.Messages
+	if req.Id != 0 {
+		if proposal, ok := k.GetProposal(sdkCtx, req.Id); ok {
+			messages = proposal.Messages
+		} else if queued, ok := k.GetQueuedProposal(sdkCtx, req.Id); ok {
+			messages = queued.Record.Proposal.Messages
+		} else {
+			return nil, status.Errorf(codes.NotFound, "There is no pending proposal with id %d", req.Id)
+		}
+	}
+	if len(messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no messages to simulate")
+	}
+
+	results, err := k.SimulateProposal(sdkCtx, messages)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.SimulateProposalResponse{Results: results}, nil
+}
@@ -9,18 +9,20 @@ import (
 	"cosmossdk.io/collections"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/cachekv"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 
-	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
 	"cosmossdk.io/log"
 
 	"github.com/axiome-pro/axm-node/util"
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
 	"github.com/axiome-pro/axm-node/x/vote/types"
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,9 +34,21 @@ type Keeper struct {
 	cdc            codec.Codec
 	storeService   corestore.KVStoreService
 	Params         collections.Item[types.Params]
+	ProposalID     collections.Sequence
+	Proposals      collections.Map[uint64, types.Proposal]
+	Agreed         collections.Map[uint64, types.Government]
+	Disagreed      collections.Map[uint64, types.Government]
+	ProposalQueue  collections.KeySet[collections.Pair[time.Time, uint64]]
+	History        collections.Map[uint64, types.ProposalHistoryRecord]
+	Timelocked     collections.Map[uint64, types.QueuedProposal]
+	ExecutionQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	PollQueueID    collections.Sequence
+	PollQueue      collections.Map[uint64, types.Poll]
 	referralKeeper types.ReferralKeeper
 	authority      sdk.AccAddress
 	accountKeeper  types.AccountKeeper
+	stakingKeeper  types.StakingKeeper
+	bankKeeper     types.BankKeeper
 
 	router baseapp.MessageRouter
 }
@@ -44,6 +58,7 @@ func NewKeeper(
 	cdc codec.Codec, storeService corestore.KVStoreService,
 	referralKeeper types.ReferralKeeper, authority sdk.AccAddress,
 	router baseapp.MessageRouter, accountKeeper types.AccountKeeper,
+	stakingKeeper types.StakingKeeper, bankKeeper types.BankKeeper,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 
@@ -52,10 +67,38 @@ func NewKeeper(
 		storeService:   storeService,
 		referralKeeper: referralKeeper,
 		accountKeeper:  accountKeeper,
+		stakingKeeper:  stakingKeeper,
+		bankKeeper:     bankKeeper,
 		Params:         collections.NewItem(sb, types.KeyParams, "params", codec.CollValue[types.Params](cdc)),
 		authority:      authority,
 		router:         router,
 	}
+	keeper.ProposalID = collections.NewSequence(sb, types.KeyProposalID, "proposal_id")
+	keeper.Proposals = collections.NewMap(
+		sb, types.KeyProposals, "proposals", collections.Uint64Key, codec.CollValue[types.Proposal](cdc),
+	)
+	keeper.Agreed = collections.NewMap(
+		sb, types.KeyProposalAgreed, "agreed", collections.Uint64Key, codec.CollValue[types.Government](cdc),
+	)
+	keeper.Disagreed = collections.NewMap(
+		sb, types.KeyProposalDisagreed, "disagreed", collections.Uint64Key, codec.CollValue[types.Government](cdc),
+	)
+	keeper.ProposalQueue = collections.NewKeySet(
+		sb, types.KeyProposalSchedule, "proposal_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+	)
+	keeper.History = collections.NewMap(
+		sb, types.KeyHistoryPrefix, "history", collections.Uint64Key, codec.CollValue[types.ProposalHistoryRecord](cdc),
+	)
+	keeper.Timelocked = collections.NewMap(
+		sb, types.KeyTimelocked, "timelocked", collections.Uint64Key, codec.CollValue[types.QueuedProposal](cdc),
+	)
+	keeper.ExecutionQueue = collections.NewKeySet(
+		sb, types.KeyExecutionQueue, "execution_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+	)
+	keeper.PollQueueID = collections.NewSequence(sb, types.KeyPollQueueID, "poll_queue_id")
+	keeper.PollQueue = collections.NewMap(
+		sb, types.KeyPollQueue, "poll_queue", collections.Uint64Key, codec.CollValue[types.Poll](cdc),
+	)
 	return keeper
 }
 
@@ -68,170 +111,145 @@ func (k Keeper) Router() baseapp.MessageRouter {
 	return k.router
 }
 
-func (k Keeper) GetCurrentProposal(ctx sdk.Context) *types.Proposal {
-	store := k.storeService.OpenKVStore(ctx)
-
-	bz, err := store.Get(types.KeyCurrentVote)
-
-	if err != nil {
-		panic(err)
-	}
-
-	if bz == nil {
-		return nil
-	}
-
-	var proposal types.Proposal
-	err = proto.Unmarshal(bz, &proposal)
-	if err != nil {
-		panic(err)
-	}
+// GetAuthority returns the address allowed to sign the messages executed by proposals.
+func (k Keeper) GetAuthority() string {
+	return k.authority.String()
+}
 
-	err = proposal.UnpackInterfaces(k.cdc)
+// GetProposal returns the open proposal with the given id.
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) (types.Proposal, bool) {
+	proposal, err := k.Proposals.Get(ctx, id)
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Proposal{}, false
+		}
 		panic(err)
 	}
-
-	return &proposal
+	return proposal, true
 }
 
-func (k Keeper) SetCurrentProposal(ctx sdk.Context, proposal types.Proposal) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := proto.Marshal(&proposal)
-	if err != nil {
+// SetProposal stores an open proposal and puts it into the end time queue.
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
 		panic(err)
 	}
-	err = store.Set(types.KeyCurrentVote, bz)
-
-	if err != nil {
+	if err := k.ProposalQueue.Set(ctx, collections.Join(*proposal.EndTime, proposal.Id)); err != nil {
 		panic(err)
 	}
 }
 
-func (k Keeper) GetAgreed(ctx sdk.Context) (gov types.Government) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := store.Get(types.KeyAgreedMembers)
-	if err != nil {
+// GetActiveProposals returns all open proposals ordered by id.
+func (k Keeper) GetActiveProposals(ctx sdk.Context) []types.ActiveProposal {
+	var res []types.ActiveProposal
+	if err := k.Proposals.Walk(ctx, nil, func(id uint64, proposal types.Proposal) (stop bool, err error) {
+		res = append(res, types.ActiveProposal{
+			Proposal:  proposal,
+			Agreed:    k.GetAgreed(ctx, id).Members,
+			Disagreed: k.GetDisagreed(ctx, id).Members,
+		})
+		return false, nil
+	}); err != nil {
 		panic(err)
 	}
-	if bz == nil {
-		return types.Government{}
-	}
+	return res
+}
 
-	if err := proto.Unmarshal(bz, &gov); err != nil {
+func (k Keeper) GetAgreed(ctx sdk.Context, id uint64) types.Government {
+	gov, err := k.Agreed.Get(ctx, id)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		panic(err)
 	}
 	return gov
 }
 
-func (k Keeper) SetAgreed(ctx sdk.Context, agreed types.Government) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := proto.Marshal(&agreed)
-	if err != nil {
-		panic(err)
-	}
-	err = store.Set(types.KeyAgreedMembers, bz)
-	if err != nil {
+func (k Keeper) SetAgreed(ctx sdk.Context, id uint64, agreed types.Government) {
+	if err := k.Agreed.Set(ctx, id, agreed); err != nil {
 		panic(err)
 	}
 }
 
-func (k Keeper) GetDisagreed(ctx sdk.Context) (gov types.Government) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := store.Get(types.KeyDisagreedMembers)
-
-	if err != nil {
+func (k Keeper) GetDisagreed(ctx sdk.Context, id uint64) types.Government {
+	gov, err := k.Disagreed.Get(ctx, id)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		panic(err)
 	}
+	return gov
+}
 
-	if bz == nil {
-		return types.Government{}
-	}
-
-	if err = proto.Unmarshal(bz, &gov); err != nil {
+func (k Keeper) SetDisagreed(ctx sdk.Context, id uint64, disagreed types.Government) {
+	if err := k.Disagreed.Set(ctx, id, disagreed); err != nil {
 		panic(err)
 	}
-	return gov
 }
 
-func (k Keeper) SetDisagreed(ctx sdk.Context, disagreed types.Government) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := proto.Marshal(&disagreed)
+// GetNextProposalID returns the id the next proposal is going to get.
+func (k Keeper) GetNextProposalID(ctx sdk.Context) uint64 {
+	id, err := k.ProposalID.Peek(ctx)
 	if err != nil {
 		panic(err)
 	}
-	err = store.Set(types.KeyDisagreedMembers, bz)
+	return id
+}
 
-	if err != nil {
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	if err := k.ProposalID.Set(ctx, id); err != nil {
 		panic(err)
 	}
 }
 
-func (k Keeper) Validate(gov types.Government,
+// Validate checks whether every governor has a live vote for the proposal and whether it is approved. A proposal is
+// approved if the share of the government that voted reaches the quorum and the share of the given votes that agree
+// reaches the threshold, both taken from the decision rule of the proposal messages. Only votes of the current
+// governors are live, those of removed governors are not counted.
+func (k Keeper) Validate(ctx sdk.Context, proposal types.Proposal,
+	gov types.Government,
 	aGov types.Government,
 	dGov types.Government,
 ) (complete bool, agreed bool) {
+	var (
+		total             = int64(len(gov.Members))
+		yes               = liveVotes(gov, aGov)
+		votes             = yes + liveVotes(gov, dGov)
+		threshold, quorum = k.GetParams(ctx).DecisionRule(proposal.Messages)
+	)
 
-	if len(gov.Members) == (len(aGov.Members) + len(dGov.Members)) {
-		complete = true
-		agreed = len(aGov.Members)*3 >= len(gov.Members)*2
-	}
+	complete = total == votes
+	agreed = yes > 0 &&
+		util.FractionInt(votes).GTE(quorum.MulInt64(total)) &&
+		util.FractionInt(yes).GTE(threshold.MulInt64(votes))
 
 	return complete, agreed
 }
 
-func (k Keeper) SaveProposalToHistory(ctx sdk.Context, store corestore.KVStore) {
-	history := types.ProposalHistoryRecord{
-		Proposal:   *k.GetCurrentProposal(ctx),
-		Government: k.GetGovernment(ctx).Members,
-		Agreed:     k.GetAgreed(ctx).Members,
-		Disagreed:  k.GetDisagreed(ctx).Members,
-		Started:    k.GetStartBlock(ctx),
-		Finished:   ctx.BlockHeight(),
-	}
-
-	historyBz, err := proto.Marshal(&history)
-	if err != nil {
-		panic(err)
-	}
-	height := make([]byte, 8)
-	binary.BigEndian.PutUint64(height, uint64(ctx.BlockHeight()))
-	key := append(types.KeyHistoryPrefix, height...)
-	store.Set(key, historyBz)
-}
-
-func (k Keeper) AddProposalHistoryRecord(ctx sdk.Context, record types.ProposalHistoryRecord) {
-	store := k.storeService.OpenKVStore(ctx)
-	historyBz, err := proto.Marshal(&record)
-	if err != nil {
-		panic(err)
-	}
-	height := make([]byte, 8)
-	binary.BigEndian.PutUint64(height, uint64(record.Finished))
-	key := append(types.KeyHistoryPrefix, height...)
-	err = store.Set(key, historyBz)
-	if err != nil {
-		panic(err)
+// liveVotes counts the voters being members of the government.
+func liveVotes(gov types.Government, voters types.Government) (n int64) {
+	for _, voter := range voters.Members {
+		for _, member := range gov.Members {
+			if voter == member {
+				n++
+				break
+			}
+		}
 	}
+	return n
 }
 
-func (k Keeper) SetStartBlock(ctx sdk.Context) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz := make([]byte, 8)
-	binary.BigEndian.PutUint64(bz, (uint64(ctx.BlockHeight())))
-	err := store.Set(types.KeyStartBlock, bz)
-	if err != nil {
-		panic(err)
+// newProposalHistoryRecord makes a history record of an open proposal finishing at the current block.
+func (k Keeper) newProposalHistoryRecord(ctx sdk.Context, proposal types.Proposal) types.ProposalHistoryRecord {
+	return types.ProposalHistoryRecord{
+		Proposal:   proposal,
+		Government: k.GetGovernment(ctx).Members,
+		Agreed:     k.GetAgreed(ctx, proposal.Id).Members,
+		Disagreed:  k.GetDisagreed(ctx, proposal.Id).Members,
+		Started:    proposal.StartBlock,
+		Finished:   ctx.BlockHeight(),
 	}
 }
 
-func (k Keeper) GetStartBlock(ctx sdk.Context) int64 {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := store.Get(types.KeyStartBlock)
-	if err != nil {
+func (k Keeper) AddProposalHistoryRecord(ctx sdk.Context, record types.ProposalHistoryRecord) {
+	if err := k.History.Set(ctx, record.Proposal.Id, record); err != nil {
 		panic(err)
 	}
-	return int64(binary.BigEndian.Uint64(bz))
 }
 
 func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
@@ -245,120 +263,270 @@ func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgService
 	return
 }
 
-func (k Keeper) EndProposal(ctx sdk.Context, proposal types.Proposal, agreed bool) {
-	store := k.storeService.OpenKVStore(ctx)
-
-	// Save proposal data to history
-	k.SaveProposalToHistory(ctx, store)
-
-	// Delete all proposal info
-	err := store.Delete(types.KeyCurrentVote)
-	if err != nil {
+// removeProposal deletes all info of an open proposal.
+func (k Keeper) removeProposal(ctx sdk.Context, proposal types.Proposal) {
+	if err := k.Proposals.Remove(ctx, proposal.Id); err != nil {
 		panic(err)
 	}
-	err = store.Delete(types.KeyAgreedMembers)
-	if err != nil {
+	if err := k.Agreed.Remove(ctx, proposal.Id); err != nil {
 		panic(err)
 	}
-	err = store.Delete(types.KeyDisagreedMembers)
-	if err != nil {
+	if err := k.Disagreed.Remove(ctx, proposal.Id); err != nil {
 		panic(err)
 	}
-	err = store.Delete(types.KeyStartBlock)
-	if err != nil {
+	if err := k.ProposalQueue.Remove(ctx, collections.Join(*proposal.EndTime, proposal.Id)); err != nil {
 		panic(err)
 	}
+}
+
+// EndProposal closes the voting for a proposal. A declined proposal goes to the history at once. An approved one is
+// either executed at once, or put into the execution queue if there is an execution delay.
+func (k Keeper) EndProposal(ctx sdk.Context, proposal types.Proposal, agreed bool) {
+	record := k.newProposalHistoryRecord(ctx, proposal)
+
+	k.removeProposal(ctx, proposal)
+
+	delay := k.GetParams(ctx).ExecutionDelay
+	switch {
+	case !agreed:
+		record.Proposal.Status = types.PROPOSAL_STATUS_REJECTED
+	case delay > 0:
+		record.Proposal.Status = types.PROPOSAL_STATUS_QUEUED
+	default:
+		record.Proposal.Status = types.PROPOSAL_STATUS_PASSED
+		k.executeProposal(ctx, &record)
+	}
 
 	util.EmitEvent(ctx,
 		&types.EventvoteFinished{
-			Name:   proposal.Name,
-			Agreed: agreed,
+			Name:             proposal.Name,
+			Agreed:           agreed,
+			Id:               proposal.Id,
+			Status:           record.Proposal.Status,
+			ExecutionStatus:  record.ExecutionStatus,
+			FailedMsgIndex:   record.FailedMsgIndex,
+			FailedMsgTypeUrl: record.FailedMsgTypeUrl,
+			Error:            record.Error,
 		},
 	)
 
-	if agreed {
-		var (
-			idx    int
-			events sdk.Events
-			msg    sdk.Msg
-		)
+	if record.Proposal.Status != types.PROPOSAL_STATUS_QUEUED {
+		k.AddProposalHistoryRecord(ctx, record)
+		return
+	}
 
-		cacheCtx, writeCache := ctx.CacheContext()
-		messages, err := sdktx.GetMsgs(proposal.Messages, "EndProposal")
+	queued := types.QueuedProposal{
+		Record: record,
+		Eta:    ctx.BlockTime().Add(time.Duration(delay) * time.Minute),
+	}
+	k.SetQueuedProposal(ctx, queued)
+
+	util.EmitEvent(ctx,
+		&types.EventProposalQueued{
+			Name: proposal.Name,
+			Id:   proposal.Id,
+			Eta:  queued.Eta,
+		},
+	)
+}
+
+// executeProposal runs the proposal messages and writes the outcome to the record. Either all of them succeed, or
+// the state is left untouched.
+func (k Keeper) executeProposal(ctx sdk.Context, record *types.ProposalHistoryRecord) {
+	var (
+		proposal = record.Proposal
+		idx      int
+		events   sdk.Events
+		msg      sdk.Msg
+	)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	messages, err := sdktx.GetMsgs(proposal.Messages, "EndProposal")
+	if err != nil {
+		panic(err)
+	}
+
+	for idx, msg = range messages {
+		handler := k.Router().Handler(msg)
+
+		var res *sdk.Result
+		k.Logger(cacheCtx).Error("handling msg", "msg", msg)
+		res, err = safeExecuteHandler(cacheCtx, msg, handler)
 		if err != nil {
-			panic(err)
+			break
 		}
 
-		for idx, msg = range messages {
-			handler := k.Router().Handler(msg)
+		events = append(events, res.GetEvents()...)
+	}
 
-			var res *sdk.Result
-			k.Logger(cacheCtx).Error("handling msg", "msg", msg)
-			res, err = safeExecuteHandler(cacheCtx, msg, handler)
-			if err != nil {
-				break
-			}
+	if err == nil {
+		// write state to the underlying multi-store
+		writeCache()
 
-			events = append(events, res.GetEvents()...)
-		}
+		// propagate the msg events to the current context
+		ctx.EventManager().EmitEvents(events)
 
-		if err == nil {
-			// write state to the underlying multi-store
-			writeCache()
+		record.ExecutionStatus = types.EXECUTION_STATUS_SUCCEEDED
+		record.Events = events.ToABCIEvents()
+	} else {
+		k.Logger(ctx).Error("could not apply vote result due to error",
+			"id", proposal.Id,
+			"name", proposal.Name,
+			"error", err,
+			"result",
+			fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err.Error()),
+		)
 
-			// propagate the msg events to the current context
-			ctx.EventManager().EmitEvents(events)
-		} else {
-			k.Logger(ctx).Error("could not apply vote result due to error",
-				"name", proposal.Name,
-				"error", err,
-				"result",
-				fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err.Error()),
-			)
+		record.ExecutionStatus = types.EXECUTION_STATUS_FAILED
+		record.FailedMsgIndex = uint32(idx)
+		record.FailedMsgTypeUrl = sdk.MsgTypeURL(msg)
+		record.Error = err.Error()
+	}
+}
+
+// GetQueuedProposal returns the approved proposal with the given id waiting for execution.
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, id uint64) (types.QueuedProposal, bool) {
+	queued, err := k.Timelocked.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.QueuedProposal{}, false
 		}
+		panic(err)
 	}
+	return queued, true
 }
 
-func (k Keeper) GetHistory(ctx sdk.Context, limit int32, page int32) []types.ProposalHistoryRecord {
-	store := k.storeService.OpenKVStore(ctx)
+// SetQueuedProposal stores an approved proposal and puts it into the execution queue.
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queued types.QueuedProposal) {
+	id := queued.Record.Proposal.Id
+	if err := k.Timelocked.Set(ctx, id, queued); err != nil {
+		panic(err)
+	}
+	if err := k.ExecutionQueue.Set(ctx, collections.Join(queued.Eta, id)); err != nil {
+		panic(err)
+	}
+}
 
-	iterator, err := store.Iterator(types.KeyHistoryPrefix, storetypes.PrefixEndBytes(types.KeyHistoryPrefix))
-	if err != nil {
+func (k Keeper) removeQueuedProposal(ctx sdk.Context, queued types.QueuedProposal) {
+	id := queued.Record.Proposal.Id
+	if err := k.Timelocked.Remove(ctx, id); err != nil {
 		panic(err)
 	}
-	defer iterator.Close()
+	if err := k.ExecutionQueue.Remove(ctx, collections.Join(queued.Eta, id)); err != nil {
+		panic(err)
+	}
+}
+
+// GetQueue returns all approved proposals waiting for execution ordered by id.
+func (k Keeper) GetQueue(ctx sdk.Context) []types.QueuedProposal {
+	var res []types.QueuedProposal
+	if err := k.Timelocked.Walk(ctx, nil, func(_ uint64, queued types.QueuedProposal) (stop bool, err error) {
+		res = append(res, queued)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// ExecuteQueuedProposal runs the messages of a proposal whose execution delay has passed.
+func (k Keeper) ExecuteQueuedProposal(ctx sdk.Context, queued types.QueuedProposal) {
+	k.removeQueuedProposal(ctx, queued)
+
+	record := queued.Record
+	record.Proposal.Status = types.PROPOSAL_STATUS_PASSED
+	k.executeProposal(ctx, &record)
+	k.AddProposalHistoryRecord(ctx, record)
+
+	util.EmitEvent(ctx,
+		&types.EventProposalExecuted{
+			Name:             record.Proposal.Name,
+			Id:               record.Proposal.Id,
+			ExecutionStatus:  record.ExecutionStatus,
+			FailedMsgIndex:   record.FailedMsgIndex,
+			FailedMsgTypeUrl: record.FailedMsgTypeUrl,
+			Error:            record.Error,
+		},
+	)
+}
+
+// Veto registers a governor's veto against an approved proposal waiting for execution. The proposal is dropped once
+// the vetoes reach the veto share of the government.
+func (k Keeper) Veto(ctx sdk.Context, id uint64, governor sdk.AccAddress) error {
+	queued, ok := k.GetQueuedProposal(ctx, id)
+	if !ok {
+		return errors.Wrapf(types.ErrProposalNotFound, "no queued proposal with id %d", id)
+	}
+
+	gov := k.GetGovernment(ctx)
+	if !gov.Contains(governor) {
+		return errors.Wrap(types.ErrSignerNotAllowed, governor.String())
+	}
+
+	vetoed := queued.GetVetoed()
+	if vetoed.Contains(governor) {
+		return errors.Wrap(types.ErrAlreadyVoted, governor.String())
+	}
+	vetoed.Append(governor)
+	queued.Vetoed = vetoed.Members
 
+	util.EmitEvent(ctx,
+		&types.EventProposalVeto{
+			Governor:   governor.String(),
+			ProposalId: id,
+		},
+	)
+
+	share := k.GetParams(ctx).VetoShare
+	if util.FractionInt(int64(len(vetoed.Members))).LT(share.MulInt64(int64(len(gov.Members)))) {
+		return k.Timelocked.Set(ctx, id, queued)
+	}
+
+	k.removeQueuedProposal(ctx, queued)
+	record := queued.Record
+	record.Proposal.Status = types.PROPOSAL_STATUS_VETOED
+	k.AddProposalHistoryRecord(ctx, record)
+
+	util.EmitEvent(ctx,
+		&types.EventProposalVetoed{
+			Name: record.Proposal.Name,
+			Id:   id,
+		},
+	)
+	return nil
+}
+
+// GetHistory returns a page of finished proposals ordered by id. Only the proposals with the given status are
+// returned, unless it is PROPOSAL_STATUS_UNSPECIFIED.
+func (k Keeper) GetHistory(ctx sdk.Context, limit int32, page int32, status types.ProposalStatus) []types.ProposalHistoryRecord {
 	records := make([]types.ProposalHistoryRecord, 0)
 	start := limit * (page - 1)
 	end := limit * page
 
-	for current := int32(0); iterator.Valid() && (current < end); iterator.Next() {
-		if current < start {
-			current++
-			continue
+	current := int32(0)
+	if err := k.History.Walk(ctx, nil, func(_ uint64, record types.ProposalHistoryRecord) (stop bool, err error) {
+		if status != types.PROPOSAL_STATUS_UNSPECIFIED && record.Proposal.Status != status {
+			return false, nil
 		}
-		current++
-		var record types.ProposalHistoryRecord
-		if err := proto.Unmarshal(iterator.Value(), &record); err != nil {
-			panic(err)
+		if current >= start {
+			records = append(records, record)
 		}
-		records = append(records, record)
+		current++
+		return current >= end, nil
+	}); err != nil {
+		panic(err)
 	}
 
 	return records
 }
 
-func (k Keeper) Propose(ctx sdk.Context, msg types.MsgPropose) error {
-	if k.GetCurrentProposal(ctx) != nil {
-		return types.ErrOtherActive
-	}
-
+func (k Keeper) Propose(ctx sdk.Context, msg types.MsgPropose) (uint64, error) {
 	var (
 		proposal = types.Proposal{}
 		gov      = k.GetGovernment(ctx)
 	)
 	if !gov.Contains(msg.GetAuthor()) {
-		return errors.Wrap(types.ErrSignerNotAllowed, msg.Author)
+		return 0, errors.Wrap(types.ErrSignerNotAllowed, msg.Author)
 	}
 
 	proposal.Name = msg.Name
@@ -368,62 +536,84 @@ func (k Keeper) Propose(ctx sdk.Context, msg types.MsgPropose) error {
 	endTime := ctx.BlockTime().Add(time.Duration(params.VotePeriod) * time.Minute)
 	proposal.EndTime = &endTime
 
-	messages, err := sdktx.GetMsgs(msg.Messages, "sdk.Msg")
-	if err != nil {
-		return errors.Wrap(err, "Unable to create proposal")
+	if err := k.validateProposalMsgs(msg.Messages); err != nil {
+		return 0, err
 	}
 
-	// validate messages
-	for _, msg := range messages {
-		signers, _, err := k.cdc.GetMsgV1Signers(msg)
-		if err != nil {
-			return err
-		}
-		if len(signers) != 1 {
-			return types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !bytes.Equal(signers[0], sdk.AccAddress(k.authority)) {
-			return errorsmod.Wrapf(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := k.router.Handler(msg)
-		if handler == nil {
-			return errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
-		}
+	proposal.Messages = msg.Messages
 
+	id, err := k.ProposalID.Next(ctx)
+	if err != nil {
+		return 0, err
 	}
-
-	proposal.Messages = msg.Messages
+	proposal.Id = id
+	proposal.Status = types.PROPOSAL_STATUS_VOTING
+	proposal.StartBlock = ctx.BlockHeight()
 
 	// Set proposal
-	k.SetCurrentProposal(ctx, proposal)
+	k.SetProposal(ctx, proposal)
 
 	// Set empty lists of voters
 	agreed, disagreed := types.Government{Members: []string{proposal.Author}}, types.Government{}
-	k.SetAgreed(ctx, agreed)
-	k.SetDisagreed(ctx, disagreed)
-	k.SetStartBlock(ctx)
+	k.SetAgreed(ctx, id, agreed)
+	k.SetDisagreed(ctx, id, disagreed)
 
 	util.EmitEvent(ctx,
 		&types.EventProposalCreated{
 			Name:   proposal.Name,
 			Author: proposal.Author,
+			Id:     id,
 		},
 	)
 
-	if complete, agree := k.Validate(gov, agreed, disagreed); complete {
+	if complete, agree := k.Validate(ctx, proposal, gov, agreed, disagreed); complete {
 		k.EndProposal(ctx, proposal, agree)
 	}
+	return id, nil
+}
+
+// validateProposalMsgs checks every message a proposal is going to execute.
+func (k Keeper) validateProposalMsgs(anys []*codectypes.Any) error {
+	messages, err := sdktx.GetMsgs(anys, "sdk.Msg")
+	if err != nil {
+		return errors.Wrap(err, "Unable to create proposal")
+	}
+
+	for _, msg := range messages {
+		if err := k.validateProposalMsg(msg); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (k Keeper) Vote(ctx sdk.Context, voter sdk.AccAddress, agree bool) error {
-	proposal := k.GetCurrentProposal(ctx)
-	if proposal == nil {
-		return types.ErrNoActiveProposals
+// validateProposalMsg checks that the message is signed by the module authority only and can be routed.
+func (k Keeper) validateProposalMsg(msg sdk.Msg) error {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 {
+		return types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !bytes.Equal(signers[0], sdk.AccAddress(k.authority)) {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+	return nil
+}
+
+func (k Keeper) Vote(ctx sdk.Context, id uint64, voter sdk.AccAddress, agree bool) error {
+	proposal, ok := k.GetProposal(ctx, id)
+	if !ok {
+		return errors.Wrapf(types.ErrProposalNotFound, "id %d", id)
 	}
 
 	gov := k.GetGovernment(ctx)
@@ -431,33 +621,125 @@ func (k Keeper) Vote(ctx sdk.Context, voter sdk.AccAddress, agree bool) error {
 		return errors.Wrap(types.ErrSignerNotAllowed, voter.String())
 	}
 
-	agreed := k.GetAgreed(ctx)
-	if agreed.Contains(voter) {
-		return errors.Wrap(types.ErrAlreadyVoted, voter.String())
-	}
-
-	disagreed := k.GetDisagreed(ctx)
-	if disagreed.Contains(voter) {
+	agreed, disagreed := k.GetAgreed(ctx, id), k.GetDisagreed(ctx, id)
+	if (agree && agreed.Contains(voter)) || (!agree && disagreed.Contains(voter)) {
 		return errors.Wrap(types.ErrAlreadyVoted, voter.String())
 	}
 
+	// A governor may change their mind until the proposal is closed
+	changed := agreed.Contains(voter) || disagreed.Contains(voter)
 	if agree {
+		disagreed.Remove(voter)
 		agreed.Append(voter)
-		k.SetAgreed(ctx, agreed)
 	} else {
+		agreed.Remove(voter)
 		disagreed.Append(voter)
-		k.SetDisagreed(ctx, disagreed)
+	}
+	k.SetAgreed(ctx, id, agreed)
+	k.SetDisagreed(ctx, id, disagreed)
+
+	if changed {
+		util.EmitEvent(ctx,
+			&types.EventProposalVoteChanged{
+				Voter:      voter.String(),
+				Agreed:     agree,
+				ProposalId: id,
+			},
+		)
+	} else {
+		util.EmitEvent(ctx,
+			&types.EventProposalVote{
+				Voter:      voter.String(),
+				Agreed:     agree,
+				ProposalId: id,
+			},
+		)
+	}
+
+	if complete, agree := k.Validate(ctx, proposal, gov, agreed, disagreed); complete {
+		k.EndProposal(ctx, proposal, agree)
+	}
+	return nil
+}
+
+// getAuthoredProposal returns the open proposal if the author is the one who submitted it.
+func (k Keeper) getAuthoredProposal(ctx sdk.Context, id uint64, author sdk.AccAddress) (types.Proposal, error) {
+	proposal, ok := k.GetProposal(ctx, id)
+	if !ok {
+		return types.Proposal{}, errors.Wrapf(types.ErrProposalNotFound, "id %d", id)
+	}
+	if proposal.Author != author.String() {
+		return types.Proposal{}, errors.Wrap(types.ErrNotProposalAuthor, author.String())
+	}
+	return proposal, nil
+}
+
+// CancelProposal withdraws an open proposal to the history. It is only possible while the author's vote is the only
+// one given.
+func (k Keeper) CancelProposal(ctx sdk.Context, id uint64, author sdk.AccAddress) error {
+	proposal, err := k.getAuthoredProposal(ctx, id, author)
+	if err != nil {
+		return err
+	}
+
+	agreed, disagreed := k.GetAgreed(ctx, id), k.GetDisagreed(ctx, id)
+	if len(disagreed.Members) != 0 || len(agreed.Members) > 1 ||
+		(len(agreed.Members) == 1 && !agreed.Contains(author)) {
+		return errors.Wrapf(types.ErrProposalHasVotes, "id %d", id)
 	}
 
+	record := k.newProposalHistoryRecord(ctx, proposal)
+	record.Proposal.Status = types.PROPOSAL_STATUS_CANCELLED
+	k.removeProposal(ctx, proposal)
+	k.AddProposalHistoryRecord(ctx, record)
+
+	util.EmitEvent(ctx,
+		&types.EventProposalCancelled{
+			Name: proposal.Name,
+			Id:   id,
+		},
+	)
+	return nil
+}
+
+// AmendProposal replaces the messages (and optionally the name) of an open proposal. The votes given so far are
+// discarded, the author is counted as agreed just like on submission, and the voting period starts over.
+func (k Keeper) AmendProposal(ctx sdk.Context, msg types.MsgAmendProposal) error {
+	author := msg.GetAuthor()
+	proposal, err := k.getAuthoredProposal(ctx, msg.ProposalId, author)
+	if err != nil {
+		return err
+	}
+	if err := k.validateProposalMsgs(msg.Messages); err != nil {
+		return err
+	}
+
+	// SetProposal queues the proposal by the new end time
+	if err := k.ProposalQueue.Remove(ctx, collections.Join(*proposal.EndTime, proposal.Id)); err != nil {
+		return err
+	}
+	endTime := ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).VotePeriod) * time.Minute)
+	proposal.EndTime = &endTime
+
+	proposal.Messages = msg.Messages
+	if msg.Name != "" {
+		proposal.Name = msg.Name
+	}
+	k.SetProposal(ctx, proposal)
+
+	agreed, disagreed := types.Government{Members: []string{proposal.Author}}, types.Government{}
+	k.SetAgreed(ctx, proposal.Id, agreed)
+	k.SetDisagreed(ctx, proposal.Id, disagreed)
+
 	util.EmitEvent(ctx,
-		&types.EventProposalVote{
-			Voter:  voter.String(),
-			Agreed: agree,
+		&types.EventProposalAmended{
+			Name: proposal.Name,
+			Id:   proposal.Id,
 		},
 	)
 
-	if complete, agree := k.Validate(gov, agreed, disagreed); complete {
-		k.EndProposal(ctx, *proposal, agree)
+	if complete, agree := k.Validate(ctx, proposal, k.GetGovernment(ctx), agreed, disagreed); complete {
+		k.EndProposal(ctx, proposal, agree)
 	}
 	return nil
 }
@@ -487,65 +769,285 @@ func (k Keeper) GetPollStatus(ctx sdk.Context) (yes, no uint64) {
 	return
 }
 
-func (k Keeper) StartPoll(ctx sdk.Context, poll types.Poll) error {
+// GetPollTallies returns the numbers of answers per option of the current poll. It returns nil for a yes/no poll.
+func (k Keeper) GetPollTallies(ctx sdk.Context, poll types.Poll) []uint64 {
+	if !poll.IsMultipleChoice() {
+		return nil
+	}
 	store := k.storeService.OpenKVStore(ctx)
+	tallies := make([]uint64, len(poll.Options))
+	for i := range tallies {
+		if bz, _ := store.Get(types.GetPollPrefixedKey(types.GetPollOptionCountKey(uint32(i)))); bz != nil {
+			tallies[i] = binary.BigEndian.Uint64(bz)
+		}
+	}
+	return tallies
+}
 
-	if _, err := store.Has(types.GetPollPrefixedKey(types.KeyPollCurrent)); err != nil {
-		return types.ErrOtherActive
+// StartPoll starts the poll, or puts it into the queue if another poll is active. An author outside the government
+// has to escrow the minimal poll deposit.
+func (k Keeper) StartPoll(ctx sdk.Context, poll types.Poll) error {
+	_, active := k.GetCurrentPoll(ctx)
+	if active && k.isPollQueueFull(ctx) {
+		return types.ErrPollQueueFull
 	}
+
 	if !util.ContainsString(k.GetGovernment(ctx).Strings(), poll.Author) {
-		return types.ErrSignerNotAllowed
+		deposit := k.GetParams(ctx).MinPollDeposit
+		if deposit.IsZero() {
+			return types.ErrSignerNotAllowed
+		}
+		author, err := sdk.AccAddressFromBech32(poll.Author)
+		if err != nil {
+			return errors.Wrap(err, "cannot parse author")
+		}
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, author, types.PollDepositAccountName, deposit); err != nil {
+			return errors.Wrap(err, "cannot escrow poll deposit")
+		}
+		poll.Deposit = deposit
 	}
 
+	if active {
+		k.enqueuePoll(ctx, poll)
+		return nil
+	}
+	return k.activatePoll(ctx, poll)
+}
+
+// activatePoll makes the poll current, it finishes after the poll period.
+func (k Keeper) activatePoll(ctx sdk.Context, poll types.Poll) error {
 	start := ctx.BlockTime()
 	end := start.Add(time.Duration(k.GetParams(ctx).PollPeriod) * time.Minute)
 	poll.StartTime = &start
 	poll.EndTime = &end
 
-	return store.Set(types.KeyPollCurrent, k.cdc.MustMarshal(&poll))
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetPollPrefixedKey(types.KeyPollCurrent), k.cdc.MustMarshal(&poll)); err != nil {
+		return err
+	}
+
+	util.EmitEvent(ctx, &types.EventPollStarted{Poll: poll})
+	return nil
+}
+
+func (k Keeper) enqueuePoll(ctx sdk.Context, poll types.Poll) {
+	id, err := k.PollQueueID.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if err = k.PollQueue.Set(ctx, id, poll); err != nil {
+		panic(err)
+	}
+}
+
+// GetPollQueue returns the polls waiting for the current one to end, in order.
+func (k Keeper) GetPollQueue(ctx sdk.Context) (res []types.Poll) {
+	if err := k.PollQueue.Walk(ctx, nil, func(_ uint64, poll types.Poll) (stop bool, err error) {
+		res = append(res, poll)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// isPollQueueFull tells whether MaxPollQueueLength polls are waiting for the current one to end. It stops walking the
+// queue as soon as the limit is reached.
+func (k Keeper) isPollQueueFull(ctx sdk.Context) bool {
+	n := 0
+	if err := k.PollQueue.Walk(ctx, nil, func(_ uint64, _ types.Poll) (stop bool, err error) {
+		n++
+		return n >= types.MaxPollQueueLength, nil
+	}); err != nil {
+		panic(err)
+	}
+	return n >= types.MaxPollQueueLength
+}
+
+// startNextPoll activates the first poll of the queue, if any.
+func (k Keeper) startNextPoll(ctx sdk.Context) error {
+	it, err := k.PollQueue.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+
+	kv, err := it.KeyValue()
+	if err != nil {
+		return err
+	}
+	if err = k.PollQueue.Remove(ctx, kv.Key); err != nil {
+		return err
+	}
+	return k.activatePoll(ctx, kv.Value)
+}
+
+// settlePollDeposit refunds the deposit of a finished poll to its author if the poll gathered enough answers, and
+// burns it otherwise.
+func (k Keeper) settlePollDeposit(ctx sdk.Context, poll types.Poll, participation uint64) error {
+	if poll.Deposit.IsZero() {
+		return nil
+	}
+
+	author, err := sdk.AccAddressFromBech32(poll.Author)
+	if err != nil {
+		return errors.Wrap(err, "cannot parse author")
+	}
+	if participation >= k.GetParams(ctx).MinPollParticipation {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PollDepositAccountName, author, poll.Deposit)
+	} else {
+		err = k.bankKeeper.BurnCoins(ctx, types.PollDepositAccountName, poll.Deposit)
+	}
+	return errors.Wrap(err, "cannot settle poll deposit")
 }
 
-func (k Keeper) Answer(ctx sdk.Context, acc string, yes bool) error {
+func (k Keeper) Answer(ctx sdk.Context, answer types.PollAnswer) error {
 	poll, ok := k.GetCurrentPoll(ctx)
 	if !ok {
 		return types.ErrNoActivePoll
 	}
 
-	_, err := sdk.AccAddressFromBech32(acc)
+	acc := answer.Acc
+	addr, err := sdk.AccAddressFromBech32(acc)
 	if err != nil {
 		panic(errors.Wrap(err, "cannot parse acc address"))
 	}
 
-	// TODO: add poll for validators
 	switch r := poll.Requirements.(type) {
+	case *types.Poll_CanValidate:
+		if _, err := k.getActiveValidator(ctx, addr); err != nil {
+			return err
+		}
 	case *types.Poll_MinStatus:
 		info, err := k.referralKeeper.Get(ctx, acc)
 		if err != nil {
-			panic(errors.Wrap(err, "cannot obtain referral info"))
+			return errors.Wrapf(types.ErrRespondentNotAllowed, "cannot obtain referral info: %s", err)
 		}
 		if info.Status < r.MinStatus {
 			return types.ErrRespondentNotAllowed
 		}
 	}
 
+	// The weight is snapshotted now, later stake changes don't affect the answer
+	weight := math.OneInt()
+	if poll.IsWeighted() {
+		if answer.Weight, err = k.pollWeight(ctx, poll, addr); err != nil {
+			return err
+		}
+		weight = answer.Weight
+	}
+
+	if err = k.setPollAnswer(ctx, poll, answer); err != nil {
+		return err
+	}
+
+	util.EmitEvent(ctx,
+		&types.EventPollAnswered{
+			Respondent: acc,
+			Yes:        answer.Ans,
+			Option:     answer.Option,
+			Weight:     weight,
+		},
+	)
+	return nil
+}
+
+// getActiveValidator returns the validator operated by the account if it is bonded and not jailed.
+func (k Keeper) getActiveValidator(ctx sdk.Context, operator sdk.AccAddress) (stakingtypes.ValidatorI, error) {
+	val, err := k.stakingKeeper.Validator(ctx, sdk.ValAddress(operator))
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return nil, errors.Wrap(types.ErrRespondentNotAllowed, "not a validator operator")
+	} else if err != nil {
+		return nil, err
+	}
+	if !val.IsBonded() || val.IsJailed() {
+		return nil, errors.Wrap(types.ErrRespondentNotAllowed, "validator is not bonded or jailed")
+	}
+	return val, nil
+}
+
+// pollWeight returns the weight of an answer to a weighted poll given by the account.
+func (k Keeper) pollWeight(ctx sdk.Context, poll types.Poll, addr sdk.AccAddress) (math.Int, error) {
+	if poll.Weighting == types.POLL_WEIGHTING_VOTING_POWER {
+		val, err := k.getActiveValidator(ctx, addr)
+		if err != nil {
+			return math.Int{}, err
+		}
+		return math.NewInt(val.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))), nil
+	}
+
+	info, err := k.referralKeeper.Get(ctx, addr.String())
+	if err != nil {
+		return math.Int{}, errors.Wrapf(types.ErrRespondentNotAllowed, "cannot obtain referral info: %s", err)
+	}
+
+	var weight *math.Int
+	switch poll.Weighting {
+	case types.POLL_WEIGHTING_SELF_DELEGATED:
+		weight = info.SelfDelegated
+	case types.POLL_WEIGHTING_TEAM_DELEGATED:
+		weight = info.TeamDelegated
+	}
+	if weight == nil || weight.IsNil() {
+		return math.ZeroInt(), nil
+	}
+	return *weight, nil
+}
+
+// setPollAnswer stores an answer to the current poll and counts it.
+func (k Keeper) setPollAnswer(ctx sdk.Context, poll types.Poll, answer types.PollAnswer) error {
+	acc := answer.Acc
 	store := k.storeService.OpenKVStore(ctx)
 	key := types.GetPollAnswersPrefixedKey([]byte(acc))
 	if has, _ := store.Has(key); has {
 		return types.ErrAlreadyVoted
 	}
 
-	var ans, countKey []byte
-	if yes {
+	var ans, countKey, weightKey []byte
+	if poll.IsMultipleChoice() {
+		if int(answer.Option) >= len(poll.Options) {
+			return errors.Wrapf(types.ErrUnknownPollOption, "option %d of %d", answer.Option, len(poll.Options))
+		}
+		ans = make([]byte, 4)
+		binary.BigEndian.PutUint32(ans, answer.Option)
+		countKey = types.GetPollOptionCountKey(answer.Option)
+		weightKey = types.GetPollOptionWeightKey(answer.Option)
+	} else if answer.Ans {
 		ans = types.ValueYes
 		countKey = types.KeyPollYesCount
+		weightKey = types.KeyPollWeightedYes
 	} else {
 		ans = types.ValueNo
 		countKey = types.KeyPollNoCount
+		weightKey = types.KeyPollWeightedNo
 	}
-	err = store.Set(key, ans)
-	if err != nil {
+	if err := store.Set(key, ans); err != nil {
 		panic(err)
 	}
+	if poll.IsWeighted() {
+		bz, err := answer.Weight.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		if err = store.Set(types.GetPollPrefixedKey(types.GetPollWeightKey([]byte(acc))), bz); err != nil {
+			panic(err)
+		}
+
+		// The running sum spares the queries and EndPoll iterating over all the answers
+		bz, err = store.Get(types.GetPollPrefixedKey(weightKey))
+		if err != nil {
+			panic(err)
+		}
+		if bz, err = parsePollWeight(bz).Add(answer.Weight).Marshal(); err != nil {
+			panic(err)
+		}
+		if err = store.Set(types.GetPollPrefixedKey(weightKey), bz); err != nil {
+			panic(err)
+		}
+	}
 
 	var (
 		bz    []byte
@@ -558,11 +1060,18 @@ func (k Keeper) Answer(ctx sdk.Context, acc string, yes bool) error {
 	}
 	value += 1
 	binary.BigEndian.PutUint64(bz, value)
-	return store.Set(types.GetPollAnswersPrefixedKey(countKey), bz)
+	return store.Set(types.GetPollPrefixedKey(countKey), bz)
+}
+
+func (k Keeper) EndPollHandler(ctx sdk.Context, _ []byte, _ time.Time) {
+	if err := k.EndPoll(ctx); err != nil {
+		panic(err)
+	}
 }
 
-func (k Keeper) EndPollHandler(ctx sdk.Context, _ []byte, _ time.Time) { k.EndPoll(ctx) }
-func (k Keeper) EndPoll(ctx sdk.Context) {
+// EndPoll finishes the current poll, settles its deposit and starts the next queued poll. Nothing is changed if the
+// deposit can't be settled, so the poll is retried later.
+func (k Keeper) EndPoll(ctx sdk.Context) error {
 	store := cachekv.NewStore(
 		prefix.NewStore(
 			runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
@@ -573,12 +1082,16 @@ func (k Keeper) EndPoll(ctx sdk.Context) {
 	var (
 		poll     types.Poll
 		yes, no  uint64
+		tallies  []uint64
 		decision types.Decision
+
+		weightedYes, weightedNo math.Int
+		weightedTallies         []math.Int
 	)
 	if bz := store.Get(types.KeyPollCurrent); bz != nil {
 		k.cdc.MustUnmarshal(bz, &poll)
 	} else {
-		panic(types.ErrNoActivePoll)
+		return types.ErrNoActivePoll
 	}
 	if bz := store.Get(types.KeyPollYesCount); bz != nil {
 		yes = binary.BigEndian.Uint64(bz)
@@ -586,20 +1099,61 @@ func (k Keeper) EndPoll(ctx sdk.Context) {
 	if bz := store.Get(types.KeyPollNoCount); bz != nil {
 		no = binary.BigEndian.Uint64(bz)
 	}
+	if poll.IsMultipleChoice() {
+		tallies = make([]uint64, len(poll.Options))
+		for i := range tallies {
+			if bz := store.Get(types.GetPollOptionCountKey(uint32(i))); bz != nil {
+				tallies[i] = binary.BigEndian.Uint64(bz)
+			}
+		}
+	}
+	if poll.IsWeighted() {
+		weightedYes, weightedNo, weightedTallies = getPollWeightSums(store, poll)
+	}
 	if poll.Quorum != nil {
-		if yes != 0 && util.FractionInt(int64(yes)).GTE(poll.Quorum.Mul(util.FractionInt(int64(yes+no)))) {
+		// The quorum is applied to 'yes', or to the leading option of a multiple-choice poll. Weighted polls sum up
+		// the answer weights instead of counting the answers.
+		var lead, total math.Int
+		switch {
+		case poll.IsMultipleChoice() && poll.IsWeighted():
+			lead, total = leadingTally(weightedTallies)
+		case poll.IsMultipleChoice():
+			counts := make([]math.Int, len(tallies))
+			for i, n := range tallies {
+				counts[i] = math.NewIntFromUint64(n)
+			}
+			lead, total = leadingTally(counts)
+		case poll.IsWeighted():
+			lead, total = weightedYes, weightedYes.Add(weightedNo)
+		default:
+			lead, total = math.NewIntFromUint64(yes), math.NewIntFromUint64(yes+no)
+		}
+		if lead.IsPositive() && util.FractionBigInt(lead.BigInt()).GTE(poll.Quorum.Mul(util.FractionBigInt(total.BigInt()))) {
 			decision = types.DECISION_POSITIVE
 		} else {
 			decision = types.DECISION_NEGATIVE
 		}
 	}
 
+	participation := yes + no
+	for _, n := range tallies {
+		participation += n
+	}
+	if err := k.settlePollDeposit(ctx, poll, participation); err != nil {
+		return err
+	}
+
 	util.EmitEvent(ctx,
 		&types.EventPollFinished{
 			Name:     poll.Name,
 			Yes:      yes,
 			No:       no,
 			Decision: decision,
+			Tallies:  tallies,
+
+			WeightedYes:     weightedYes,
+			WeightedNo:      weightedNo,
+			WeightedTallies: weightedTallies,
 		},
 	)
 
@@ -611,6 +1165,11 @@ func (k Keeper) EndPoll(ctx sdk.Context) {
 		Yes:      yes,
 		No:       no,
 		Decision: decision,
+		Tallies:  tallies,
+
+		WeightedYes:     weightedYes,
+		WeightedNo:      weightedNo,
+		WeightedTallies: weightedTallies,
 	}))
 
 	store.Delete(types.KeyPollCurrent)
@@ -621,8 +1180,83 @@ func (k Keeper) EndPoll(ctx sdk.Context) {
 		store.Delete(it.Key())
 	}
 	it.Close()
+	it = storetypes.KVStorePrefixIterator(store, types.KeyPollOptionCount)
+	for ; it.Valid(); it.Next() {
+		store.Delete(it.Key())
+	}
+	it.Close()
+	it = storetypes.KVStorePrefixIterator(store, types.KeyPollWeights)
+	for ; it.Valid(); it.Next() {
+		store.Delete(it.Key())
+	}
+	it.Close()
+	store.Delete(types.KeyPollWeightedYes)
+	store.Delete(types.KeyPollWeightedNo)
+	it = storetypes.KVStorePrefixIterator(store, types.KeyPollOptionWeight)
+	for ; it.Valid(); it.Next() {
+		store.Delete(it.Key())
+	}
+	it.Close()
 
 	store.Write()
+
+	return k.startNextPoll(ctx)
+}
+
+// leadingTally returns the tally of the leading option and the total of all tallies. If several options share the
+// lead, none of them is leading and 0 is returned for it.
+func leadingTally(tallies []math.Int) (lead, total math.Int) {
+	var tie bool
+	lead, total = math.ZeroInt(), math.ZeroInt()
+	for _, n := range tallies {
+		total = total.Add(n)
+		if n.GT(lead) {
+			lead, tie = n, false
+		} else if n.Equal(lead) {
+			tie = true
+		}
+	}
+	if tie {
+		lead = math.ZeroInt()
+	}
+	return lead, total
+}
+
+// GetPollWeights returns the sums of the answer weights of the current poll so far. It returns zeros for a poll not
+// weighted.
+func (k Keeper) GetPollWeights(ctx sdk.Context, poll types.Poll) (yes, no math.Int, tallies []math.Int) {
+	if !poll.IsWeighted() {
+		return math.ZeroInt(), math.ZeroInt(), nil
+	}
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPollPrefix,
+	)
+	return getPollWeightSums(store, poll)
+}
+
+// getPollWeightSums reads the running sums of the answer weights of a weighted poll. The store is the one under
+// KeyPollPrefix.
+func getPollWeightSums(store storetypes.KVStore, poll types.Poll) (yes, no math.Int, tallies []math.Int) {
+	yes = parsePollWeight(store.Get(types.KeyPollWeightedYes))
+	no = parsePollWeight(store.Get(types.KeyPollWeightedNo))
+	if poll.IsMultipleChoice() {
+		tallies = make([]math.Int, len(poll.Options))
+		for i := range tallies {
+			tallies[i] = parsePollWeight(store.Get(types.GetPollOptionWeightKey(uint32(i))))
+		}
+	}
+	return yes, no, tallies
+}
+
+// parsePollWeight decodes a stored answer weight or weight sum, a missing one is zero.
+func parsePollWeight(bz []byte) math.Int {
+	weight := math.ZeroInt()
+	if bz != nil {
+		if err := weight.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	return weight
 }
 
 func (k Keeper) GetPollHistoryAll(ctx sdk.Context) []types.PollHistoryItem {
@@ -651,13 +1285,14 @@ func (k Keeper) GetPollHistory(ctx sdk.Context, limit int32, page int32) []types
 	return res
 }
 
-func (k Keeper) IterateThroughCurrentPollAnswers(ctx sdk.Context, callback func(acc string, ans bool) (stop bool)) (err error) {
+func (k Keeper) IterateThroughCurrentPollAnswers(ctx sdk.Context, callback func(ans types.PollAnswer) (stop bool)) (err error) {
+	poll, ok := k.GetCurrentPoll(ctx)
+	if !ok {
+		return types.ErrNoActivePoll
+	}
 	store := prefix.NewStore(
 		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPollPrefix,
 	)
-	if !store.Has(types.KeyPollCurrent) {
-		return types.ErrNoActivePoll
-	}
 
 	it := storetypes.KVStorePrefixIterator(store, types.KeyPollAnswers)
 	defer func() {
@@ -672,9 +1307,21 @@ func (k Keeper) IterateThroughCurrentPollAnswers(ctx sdk.Context, callback func(
 	}()
 
 	for ; it.Valid(); it.Next() {
-		acc := string(it.Key()[len(types.KeyPollAnswers):])
-		ans := bytes.Equal(it.Value(), types.ValueYes)
-		if stop := callback(acc, ans); stop {
+		ans := types.PollAnswer{Acc: string(it.Key()[len(types.KeyPollAnswers):])}
+		if poll.IsMultipleChoice() {
+			ans.Option = binary.BigEndian.Uint32(it.Value())
+		} else {
+			ans.Ans = bytes.Equal(it.Value(), types.ValueYes)
+		}
+		if poll.IsWeighted() {
+			ans.Weight = math.ZeroInt()
+			if bz := store.Get(types.GetPollWeightKey([]byte(ans.Acc))); bz != nil {
+				if err := ans.Weight.Unmarshal(bz); err != nil {
+					panic(err)
+				}
+			}
+		}
+		if stop := callback(ans); stop {
 			return nil
 		}
 	}
@@ -689,12 +1336,16 @@ func (k Keeper) LoadPolls(ctx sdk.Context, state types.GenesisState) {
 	if state.CurrentPoll != nil {
 		store.Set(types.KeyPollCurrent, k.cdc.MustMarshal(state.CurrentPoll))
 		for _, ans := range state.PollAnswers {
-			if err := k.Answer(ctx, ans.Acc, ans.Ans); err != nil {
+			if err := k.setPollAnswer(ctx, *state.CurrentPoll, ans); err != nil {
 				panic(err)
 			}
 		}
 	}
 
+	for _, poll := range state.PollQueue {
+		k.enqueuePoll(ctx, poll)
+	}
+
 	key := make([]byte, len(types.KeyPollHistory)+8)
 	copy(key, types.KeyPollHistory)
 	for _, item := range state.PollHistory {
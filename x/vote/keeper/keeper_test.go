@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/axiome-pro/axm-node/x/vote/keeper"
+	votetestutil "github.com/axiome-pro/axm-node/x/vote/testutil"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	governors = []sdk.AccAddress{
+		sdk.AccAddress([]byte("governor1___________")),
+		sdk.AccAddress([]byte("governor2___________")),
+		sdk.AccAddress([]byte("governor3___________")),
+	}
+	newcomer = sdk.AccAddress([]byte("newcomer____________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	cdc          codec.Codec
+	storeService corestore.KVStoreService
+	voteKeeper   keeper.Keeper
+	msgServer    types.MsgServer
+
+	referralKeeper *votetestutil.MockReferralKeeper
+	stakingKeeper  *votetestutil.MockStakingKeeper
+	bankKeeper     *votetestutil.MockBankKeeper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.ModuleName)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := sdktestutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: cmttime.Now(), Height: 10})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := votetestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	s.referralKeeper = votetestutil.NewMockReferralKeeper(ctrl)
+	s.stakingKeeper = votetestutil.NewMockStakingKeeper(ctrl)
+	s.bankKeeper = votetestutil.NewMockBankKeeper(ctrl)
+
+	s.ctx = ctx
+	s.cdc = encCfg.Codec
+	s.storeService = storeService
+	s.voteKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		s.referralKeeper,
+		authority,
+		router,
+		accountKeeper,
+		s.stakingKeeper,
+		s.bankKeeper,
+	)
+	s.voteKeeper.SetParams(ctx, types.DefaultParams())
+	s.voteKeeper.SetNextProposalID(ctx, 1)
+
+	gov := types.Government{}
+	for _, addr := range governors {
+		gov.Append(addr)
+	}
+	s.voteKeeper.SetGovernment(ctx, gov)
+
+	s.msgServer = keeper.MsgServer(s.voteKeeper)
+	types.RegisterMsgServer(router, s.msgServer)
+}
+
+// propose submits a proposal adding the newcomer to the government on behalf of the first governor.
+func (s *KeeperTestSuite) propose() uint64 {
+	s.T().Helper()
+	msg, err := codectypes.NewAnyWithValue(&types.MsgAddGovernor{
+		Authority: authority.String(),
+		Governor:  newcomer.String(),
+	})
+	s.Require().NoError(err)
+
+	id, err := s.voteKeeper.Propose(s.ctx, types.MsgPropose{
+		Messages: []*codectypes.Any{msg},
+		Author:   governors[0].String(),
+		Name:     "add newcomer",
+	})
+	s.Require().NoError(err)
+	return id
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -29,11 +29,12 @@ func (ms MsgServer) Propose(ctx context.Context, msg *types.MsgPropose) (*types.
 		return nil, err
 	}
 
-	if err := k.Propose(sdkCtx, *msg); err != nil {
+	id, err := k.Propose(sdkCtx, *msg)
+	if err != nil {
 		return nil, err
 	}
 	util.TagTx(sdkCtx, types.ModuleName, msg)
-	return &types.MsgProposeResponse{}, nil
+	return &types.MsgProposeResponse{Id: id}, nil
 }
 
 func (ms MsgServer) Vote(ctx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
@@ -41,13 +42,49 @@ func (ms MsgServer) Vote(ctx context.Context, msg *types.MsgVote) (*types.MsgVot
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = Keeper(ms)
 	)
-	if err := k.Vote(sdkCtx, msg.GetVoter(), msg.Agree); err != nil {
+	if err := k.Vote(sdkCtx, msg.ProposalId, msg.GetVoter(), msg.Agree); err != nil {
 		return nil, err
 	}
 	util.TagTx(sdkCtx, types.ModuleName, msg)
 	return &types.MsgVoteResponse{}, nil
 }
 
+func (ms MsgServer) Veto(ctx context.Context, msg *types.MsgVeto) (*types.MsgVetoResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(ms)
+	)
+	if err := k.Veto(sdkCtx, msg.ProposalId, msg.GetGovernor()); err != nil {
+		return nil, err
+	}
+	util.TagTx(sdkCtx, types.ModuleName, msg)
+	return &types.MsgVetoResponse{}, nil
+}
+
+func (ms MsgServer) CancelProposal(ctx context.Context, msg *types.MsgCancelProposal) (*types.MsgCancelProposalResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(ms)
+	)
+	if err := k.CancelProposal(sdkCtx, msg.ProposalId, msg.GetAuthor()); err != nil {
+		return nil, err
+	}
+	util.TagTx(sdkCtx, types.ModuleName, msg)
+	return &types.MsgCancelProposalResponse{}, nil
+}
+
+func (ms MsgServer) AmendProposal(ctx context.Context, msg *types.MsgAmendProposal) (*types.MsgAmendProposalResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(ms)
+	)
+	if err := k.AmendProposal(sdkCtx, *msg); err != nil {
+		return nil, err
+	}
+	util.TagTx(sdkCtx, types.ModuleName, msg)
+	return &types.MsgAmendProposalResponse{}, nil
+}
+
 func (ms MsgServer) StartPoll(ctx context.Context, msg *types.MsgStartPoll) (*types.MsgStartPollResponse, error) {
 	var (
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
@@ -65,7 +102,7 @@ func (ms MsgServer) AnswerPoll(ctx context.Context, msg *types.MsgAnswerPoll) (*
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = Keeper(ms)
 	)
-	if err := k.Answer(sdkCtx, msg.Respondent, msg.Yes); err != nil {
+	if err := k.Answer(sdkCtx, types.PollAnswer{Acc: msg.Respondent, Ans: msg.Yes, Option: msg.Option}); err != nil {
 		return nil, err
 	}
 	util.TagTx(sdkCtx, types.ModuleName, msg)
@@ -0,0 +1,385 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/util"
+	referraltypes "github.com/axiome-pro/axm-node/x/referral/types"
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
+	"github.com/axiome-pro/axm-node/x/vote/keeper"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var respondents = []sdk.AccAddress{
+	sdk.AccAddress([]byte("respondent1_________")),
+	sdk.AccAddress([]byte("respondent2_________")),
+	sdk.AccAddress([]byte("respondent3_________")),
+	sdk.AccAddress([]byte("respondent4_________")),
+}
+
+func newPoll(author sdk.AccAddress, name string, quorum util.Fraction) types.Poll {
+	return types.Poll{
+		Name:     name,
+		Author:   author.String(),
+		Question: name + "?",
+		Quorum:   &quorum,
+	}
+}
+
+func (s *KeeperTestSuite) answer(acc sdk.AccAddress, yes bool, option uint32) error {
+	return s.voteKeeper.Answer(s.ctx, types.PollAnswer{Acc: acc.String(), Ans: yes, Option: option})
+}
+
+// expectReferralInfo makes the referral keeper return the delegations of the account exactly once, so that reading
+// them again after the answer is given fails the test.
+func (s *KeeperTestSuite) expectReferralInfo(acc sdk.AccAddress, self, team int64) {
+	selfDelegated, teamDelegated := math.NewInt(self), math.NewInt(team)
+	s.referralKeeper.EXPECT().Get(gomock.Any(), acc.String()).Return(referraltypes.Info{
+		SelfDelegated: &selfDelegated,
+		TeamDelegated: &teamDelegated,
+	}, nil).Times(1)
+}
+
+// expectValidator makes the account the operator of a bonded validator with the tokens.
+func (s *KeeperTestSuite) expectValidator(acc sdk.AccAddress, tokens int64, jailed bool) {
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(acc)).Return(stakingtypes.Validator{
+		Status: stakingtypes.Bonded,
+		Tokens: math.NewInt(tokens),
+		Jailed: jailed,
+	}, nil).AnyTimes()
+}
+
+// currentPollWeights collects the answer weights of the current poll by respondent.
+func (s *KeeperTestSuite) currentPollWeights() map[string]int64 {
+	weights := make(map[string]int64)
+	s.Require().NoError(s.voteKeeper.IterateThroughCurrentPollAnswers(s.ctx, func(ans types.PollAnswer) (stop bool) {
+		weights[ans.Acc] = ans.Weight.Int64()
+		return false
+	}))
+	return weights
+}
+
+func (s *KeeperTestSuite) requireInts(expected []int64, actual []math.Int) {
+	s.T().Helper()
+	s.Require().Len(actual, len(expected))
+	for i, n := range expected {
+		s.Require().True(actual[i].Equal(math.NewInt(n)), "#%d: %s", i, actual[i])
+	}
+}
+
+func (s *KeeperTestSuite) TestPollOptionTallies() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	poll := newPoll(governors[0], "colour", util.NewFraction(1, 2))
+	poll.Options = []string{"red", "green", "blue"}
+	require.NoError(k.StartPoll(ctx, poll))
+	poll, ok := k.GetCurrentPoll(ctx)
+	require.True(ok)
+	require.True(poll.Deposit.IsZero())
+
+	require.NoError(s.answer(respondents[0], false, 0))
+	require.NoError(s.answer(respondents[1], false, 2))
+	require.NoError(s.answer(respondents[2], false, 0))
+	err := s.answer(respondents[3], false, 3)
+	require.True(errors.Is(err, types.ErrUnknownPollOption), err)
+	err = s.answer(respondents[0], false, 1)
+	require.True(errors.Is(err, types.ErrAlreadyVoted), err)
+
+	require.Equal([]uint64{2, 0, 1}, k.GetPollTallies(ctx, poll))
+	yes, no := k.GetPollStatus(ctx)
+	require.Zero(yes)
+	require.Zero(no)
+
+	require.NoError(k.EndPoll(ctx))
+	_, ok = k.GetCurrentPoll(ctx)
+	require.False(ok)
+
+	history := k.GetPollHistoryAll(ctx)
+	require.Len(history, 1)
+	require.Equal([]uint64{2, 0, 1}, history[0].Tallies)
+	require.Equal(types.DECISION_POSITIVE, history[0].Decision)
+}
+
+func (s *KeeperTestSuite) TestLeadingTally() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	tests := []struct {
+		name    string
+		tallies []int64
+		lead    int64
+		total   int64
+	}{
+		{"no options", nil, 0, 0},
+		{"no answers", []int64{0, 0}, 0, 0},
+		{"single leader", []int64{3, 1, 2}, 3, 6},
+		{"tie for the lead", []int64{2, 2, 1}, 0, 5},
+		{"tie for the lead at the end", []int64{1, 3, 3}, 0, 7},
+		{"tie broken later", []int64{3, 3, 4}, 4, 10},
+		{"tie below the lead", []int64{4, 1, 1}, 4, 6},
+	}
+	for _, tt := range tests {
+		tallies := make([]math.Int, len(tt.tallies))
+		for i, n := range tt.tallies {
+			tallies[i] = math.NewInt(n)
+		}
+		lead, total := keeper.LeadingTally(tallies)
+		require.True(lead.Equal(math.NewInt(tt.lead)), "%s: lead %s", tt.name, lead)
+		require.True(total.Equal(math.NewInt(tt.total)), "%s: total %s", tt.name, total)
+	}
+
+	// each option has a half of the answers, above the quorum, but none of them leads
+	poll := newPoll(governors[0], "tie", util.NewFraction(1, 3))
+	poll.Options = []string{"a", "b"}
+	require.NoError(k.StartPoll(ctx, poll))
+	require.NoError(s.answer(respondents[0], false, 0))
+	require.NoError(s.answer(respondents[1], false, 1))
+	require.NoError(k.EndPoll(ctx))
+
+	history := k.GetPollHistoryAll(ctx)
+	require.Len(history, 1)
+	require.Equal([]uint64{1, 1}, history[0].Tallies)
+	require.Equal(types.DECISION_NEGATIVE, history[0].Decision)
+}
+
+func (s *KeeperTestSuite) TestWeightedPoll() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	poll := newPoll(governors[0], "weighted", util.NewFraction(1, 2))
+	poll.Weighting = types.POLL_WEIGHTING_SELF_DELEGATED
+	require.NoError(k.StartPoll(ctx, poll))
+
+	s.expectReferralInfo(respondents[0], 100, 1_000)
+	s.expectReferralInfo(respondents[1], 30, 1_000)
+	s.expectReferralInfo(respondents[2], 50, 1_000)
+	s.referralKeeper.EXPECT().Get(gomock.Any(), respondents[3].String()).Return(referraltypes.Info{}, nil).Times(1)
+
+	require.NoError(s.answer(respondents[0], true, 0))
+	require.NoError(s.answer(respondents[1], false, 0))
+	yes, no, tallies := k.GetPollWeights(ctx, poll)
+	require.True(yes.Equal(math.NewInt(100)), yes)
+	require.True(no.Equal(math.NewInt(30)), no)
+	require.Nil(tallies)
+
+	require.NoError(s.answer(respondents[2], false, 0))
+	// an account without delegations answers with no weight
+	require.NoError(s.answer(respondents[3], true, 0))
+
+	// the weights snapshotted with the answers are kept, the stakes are not read again
+	require.Equal(map[string]int64{
+		respondents[0].String(): 100,
+		respondents[1].String(): 30,
+		respondents[2].String(): 50,
+		respondents[3].String(): 0,
+	}, s.currentPollWeights())
+	yes, no, _ = k.GetPollWeights(ctx, poll)
+	require.True(yes.Equal(math.NewInt(100)), yes)
+	require.True(no.Equal(math.NewInt(80)), no)
+	yesCount, noCount := k.GetPollStatus(ctx)
+	require.Equal(uint64(2), yesCount)
+	require.Equal(uint64(2), noCount)
+
+	// the weights decide: 100 of 180 pass the quorum of a half
+	require.NoError(k.EndPoll(ctx))
+	history := k.GetPollHistoryAll(ctx)
+	require.Len(history, 1)
+	require.Equal(uint64(2), history[0].Yes)
+	require.Equal(uint64(2), history[0].No)
+	require.True(history[0].WeightedYes.Equal(math.NewInt(100)), history[0].WeightedYes)
+	require.True(history[0].WeightedNo.Equal(math.NewInt(80)), history[0].WeightedNo)
+	require.Equal(types.DECISION_POSITIVE, history[0].Decision)
+
+	_, ok := k.GetCurrentPoll(ctx)
+	require.False(ok)
+	yes, no, _ = k.GetPollWeights(ctx, poll)
+	require.True(yes.IsZero(), yes)
+	require.True(no.IsZero(), no)
+}
+
+func (s *KeeperTestSuite) TestWeightedPollOptions() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	poll := newPoll(governors[0], "weighted options", util.NewFraction(3, 5))
+	poll.Options = []string{"a", "b"}
+	poll.Weighting = types.POLL_WEIGHTING_TEAM_DELEGATED
+	require.NoError(k.StartPoll(ctx, poll))
+
+	s.expectReferralInfo(respondents[0], 1_000, 10)
+	s.expectReferralInfo(respondents[1], 1_000, 25)
+	s.expectReferralInfo(respondents[2], 1_000, 20)
+
+	require.NoError(s.answer(respondents[0], false, 1))
+	require.NoError(s.answer(respondents[1], false, 0))
+	require.NoError(s.answer(respondents[2], false, 1))
+
+	yes, no, tallies := k.GetPollWeights(ctx, poll)
+	require.True(yes.IsZero(), yes)
+	require.True(no.IsZero(), no)
+	s.requireInts([]int64{25, 30}, tallies)
+	require.Equal([]uint64{1, 2}, k.GetPollTallies(ctx, poll))
+
+	// two thirds of the answers would pass the quorum, but 30 of 55 weigh less than three fifths
+	require.NoError(k.EndPoll(ctx))
+	history := k.GetPollHistoryAll(ctx)
+	require.Len(history, 1)
+	require.Equal([]uint64{1, 2}, history[0].Tallies)
+	s.requireInts([]int64{25, 30}, history[0].WeightedTallies)
+	require.Equal(types.DECISION_NEGATIVE, history[0].Decision)
+}
+
+func (s *KeeperTestSuite) TestVotingPowerPoll() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	poll := newPoll(governors[0], "validators", util.NewFraction(2, 3))
+	poll.Requirements = &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}}
+	poll.Weighting = types.POLL_WEIGHTING_VOTING_POWER
+	require.NoError(poll.Validate())
+	require.NoError(k.StartPoll(ctx, poll))
+
+	s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+	s.expectValidator(respondents[0], 5_000_000, false)
+	s.expectValidator(respondents[1], 2_000_000, false)
+	s.expectValidator(respondents[2], 9_000_000, true)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(respondents[3])).
+		Return(nil, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	require.NoError(s.answer(respondents[0], true, 0))
+	require.NoError(s.answer(respondents[1], false, 0))
+	err := s.answer(respondents[2], true, 0)
+	require.True(errors.Is(err, types.ErrRespondentNotAllowed), err)
+	err = s.answer(respondents[3], true, 0)
+	require.True(errors.Is(err, types.ErrRespondentNotAllowed), err)
+
+	require.Equal(map[string]int64{
+		respondents[0].String(): 5,
+		respondents[1].String(): 2,
+	}, s.currentPollWeights())
+	yes, no, _ := k.GetPollWeights(ctx, poll)
+	require.True(yes.Equal(math.NewInt(5)), yes)
+	require.True(no.Equal(math.NewInt(2)), no)
+
+	// a half of the answers would fall short of two thirds, 5 of 7 consensus power don't
+	require.NoError(k.EndPoll(ctx))
+	history := k.GetPollHistoryAll(ctx)
+	require.Len(history, 1)
+	require.Equal(uint64(1), history[0].Yes)
+	require.Equal(uint64(1), history[0].No)
+	require.True(history[0].WeightedYes.Equal(math.NewInt(5)), history[0].WeightedYes)
+	require.True(history[0].WeightedNo.Equal(math.NewInt(2)), history[0].WeightedNo)
+	require.Equal(types.DECISION_POSITIVE, history[0].Decision)
+}
+
+func (s *KeeperTestSuite) TestPollDeposit() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	quorum := util.NewFraction(1, 2)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+
+	// without a deposit set only the government starts polls
+	err := k.StartPoll(ctx, newPoll(newcomer, "outsider", quorum))
+	require.True(errors.Is(err, types.ErrSignerNotAllowed), err)
+
+	params := k.GetParams(ctx)
+	params.MinPollDeposit = deposit
+	params.MinPollParticipation = 2
+	k.SetParams(ctx, params)
+
+	// the deposit can't be escrowed
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), newcomer, types.PollDepositAccountName, deposit).
+		Return(sdkerrors.ErrInsufficientFunds)
+	err = k.StartPoll(ctx, newPoll(newcomer, "outsider", quorum))
+	require.True(errors.Is(err, sdkerrors.ErrInsufficientFunds), err)
+	_, ok := k.GetCurrentPoll(ctx)
+	require.False(ok)
+
+	// enough answers, the deposit is refunded
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), newcomer, types.PollDepositAccountName, deposit).
+		Return(nil)
+	require.NoError(k.StartPoll(ctx, newPoll(newcomer, "refunded", quorum)))
+	poll, ok := k.GetCurrentPoll(ctx)
+	require.True(ok)
+	require.True(poll.Deposit.Equal(deposit), poll.Deposit)
+
+	require.NoError(s.answer(respondents[0], true, 0))
+	require.NoError(s.answer(respondents[1], false, 0))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.PollDepositAccountName, newcomer, deposit).
+		Return(nil)
+	require.NoError(k.EndPoll(ctx))
+
+	// too few answers, the deposit is burnt
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), newcomer, types.PollDepositAccountName, deposit).
+		Return(nil)
+	require.NoError(k.StartPoll(ctx, newPoll(newcomer, "burnt", quorum)))
+	require.NoError(s.answer(respondents[0], true, 0))
+
+	// the poll stays current until its deposit is settled
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.PollDepositAccountName, deposit).
+		Return(errors.New("cannot burn"))
+	require.Error(k.EndPoll(ctx))
+	poll, ok = k.GetCurrentPoll(ctx)
+	require.True(ok)
+	require.Equal("burnt", poll.Name)
+	yes, _ := k.GetPollStatus(ctx)
+	require.Equal(uint64(1), yes)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.PollDepositAccountName, deposit).Return(nil)
+	require.NoError(k.EndPoll(ctx))
+	_, ok = k.GetCurrentPoll(ctx)
+	require.False(ok)
+
+	// the government doesn't escrow anything
+	require.NoError(k.StartPoll(ctx, newPoll(governors[0], "free", quorum)))
+	poll, ok = k.GetCurrentPoll(ctx)
+	require.True(ok)
+	require.True(poll.Deposit.IsZero())
+	require.NoError(k.EndPoll(ctx))
+}
+
+func (s *KeeperTestSuite) TestPollQueue() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	quorum := util.NewFraction(1, 2)
+	for i := 0; i <= types.MaxPollQueueLength; i++ {
+		require.NoError(k.StartPoll(ctx, newPoll(governors[0], fmt.Sprintf("poll %d", i), quorum)))
+	}
+	poll, ok := k.GetCurrentPoll(ctx)
+	require.True(ok)
+	require.Equal("poll 0", poll.Name)
+	require.Len(k.GetPollQueue(ctx), types.MaxPollQueueLength)
+
+	err := k.StartPoll(ctx, newPoll(governors[0], "overflow", quorum))
+	require.True(errors.Is(err, types.ErrPollQueueFull), err)
+
+	// the overflow is rejected before any deposit is escrowed
+	params := k.GetParams(ctx)
+	params.MinPollDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	k.SetParams(ctx, params)
+	err = k.StartPoll(ctx, newPoll(newcomer, "overflow", quorum))
+	require.True(errors.Is(err, types.ErrPollQueueFull), err)
+
+	// the queue moves when the current poll ends
+	require.NoError(k.EndPoll(ctx))
+	poll, ok = k.GetCurrentPoll(ctx)
+	require.True(ok)
+	require.Equal("poll 1", poll.Name)
+	require.NotNil(poll.StartTime)
+	require.Len(k.GetPollQueue(ctx), types.MaxPollQueueLength-1)
+
+	require.NoError(k.StartPoll(ctx, newPoll(governors[0], "next", quorum)))
+	queue := k.GetPollQueue(ctx)
+	require.Len(queue, types.MaxPollQueueLength)
+	require.Equal("next", queue[len(queue)-1].Name)
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/util"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newGovernment(members ...sdk.AccAddress) types.Government {
+	gov := types.Government{}
+	for _, addr := range members {
+		gov.Append(addr)
+	}
+	return gov
+}
+
+// hasEvent tells whether an event of the type was emitted in the context.
+func (s *KeeperTestSuite) hasEvent(eventType string) bool {
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *KeeperTestSuite) TestDecisionRule() {
+	require := s.Require()
+
+	addGovernor := &codectypes.Any{TypeUrl: sdk.MsgTypeURL(&types.MsgAddGovernor{})}
+	removeGovernor := &codectypes.Any{TypeUrl: sdk.MsgTypeURL(&types.MsgRemoveGovernor{})}
+	updateParams := &codectypes.Any{TypeUrl: sdk.MsgTypeURL(&types.MsgUpdateParams{})}
+
+	params := types.DefaultParams()
+	params.MsgRules = []types.MsgRule{
+		{MsgTypeUrl: addGovernor.TypeUrl, Threshold: util.NewFraction(1, 2), Quorum: util.NewFraction(2, 3)},
+		{MsgTypeUrl: removeGovernor.TypeUrl, Threshold: util.FractionInt(1), Quorum: util.NewFraction(1, 3)},
+	}
+	require.NoError(params.Validate())
+
+	tests := []struct {
+		name      string
+		msgs      []*codectypes.Any
+		threshold util.Fraction
+		quorum    util.Fraction
+	}{
+		{"no messages", nil, params.Threshold, params.Quorum},
+		{"no rule", []*codectypes.Any{updateParams}, params.Threshold, params.Quorum},
+		{"rule", []*codectypes.Any{addGovernor}, util.NewFraction(1, 2), util.NewFraction(2, 3)},
+		{"strictest of rules", []*codectypes.Any{addGovernor, removeGovernor}, util.FractionInt(1), util.NewFraction(2, 3)},
+		{"strictest of rule and default", []*codectypes.Any{addGovernor, updateParams}, params.Threshold, params.Quorum},
+	}
+	for _, tt := range tests {
+		threshold, quorum := params.DecisionRule(tt.msgs)
+		require.True(threshold.Equal(tt.threshold), "%s: threshold %s", tt.name, threshold)
+		require.True(quorum.Equal(tt.quorum), "%s: quorum %s", tt.name, quorum)
+	}
+}
+
+func (s *KeeperTestSuite) TestValidate() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	addGovernor := &codectypes.Any{TypeUrl: sdk.MsgTypeURL(&types.MsgAddGovernor{})}
+	ruled := types.Proposal{Messages: []*codectypes.Any{addGovernor}}
+	unruled := types.Proposal{}
+
+	gov := k.GetGovernment(ctx)
+	g0, g1, g2 := governors[0], governors[1], governors[2]
+
+	// the default rule: everyone votes, two thirds agree
+	tests := []struct {
+		name      string
+		proposal  types.Proposal
+		agreed    types.Government
+		disagreed types.Government
+		complete  bool
+		approved  bool
+	}{
+		{"single vote", unruled, newGovernment(g0), newGovernment(), false, false},
+		{"two thirds agree", unruled, newGovernment(g0, g1), newGovernment(g2), true, true},
+		{"one third agrees", unruled, newGovernment(g0), newGovernment(g1, g2), true, false},
+		{"nobody agrees", unruled, newGovernment(), newGovernment(g0, g1, g2), true, false},
+		{"outsider votes are not live", unruled, newGovernment(g0, g1, newcomer), newGovernment(), false, false},
+	}
+	for _, tt := range tests {
+		complete, approved := k.Validate(ctx, tt.proposal, gov, tt.agreed, tt.disagreed)
+		require.Equal(tt.complete, complete, tt.name)
+		require.Equal(tt.approved, approved, tt.name)
+	}
+
+	// a msg rule only applies to the proposals carrying the message
+	params := k.GetParams(ctx)
+	params.MsgRules = []types.MsgRule{
+		{MsgTypeUrl: addGovernor.TypeUrl, Threshold: util.NewFraction(1, 2), Quorum: util.NewFraction(2, 3)},
+	}
+	k.SetParams(ctx, params)
+
+	complete, approved := k.Validate(ctx, ruled, gov, newGovernment(g0), newGovernment(g1))
+	require.False(complete)
+	require.True(approved)
+
+	complete, approved = k.Validate(ctx, unruled, gov, newGovernment(g0), newGovernment(g1))
+	require.False(complete)
+	require.False(approved)
+
+	complete, approved = k.Validate(ctx, ruled, gov, newGovernment(g0), newGovernment())
+	require.False(complete)
+	require.False(approved)
+}
+
+func (s *KeeperTestSuite) TestVoteChange() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	id := s.propose()
+	require.Equal([]string{governors[0].String()}, k.GetAgreed(ctx, id).Members)
+
+	err := k.Vote(ctx, id+1, governors[1], true)
+	require.True(errors.Is(err, types.ErrProposalNotFound), err)
+	err = k.Vote(ctx, id, newcomer, true)
+	require.True(errors.Is(err, types.ErrSignerNotAllowed), err)
+
+	require.NoError(k.Vote(ctx, id, governors[1], false))
+	require.True(k.GetDisagreed(ctx, id).Contains(governors[1]))
+	require.False(s.hasEvent("proposal_vote_changed"))
+
+	err = k.Vote(ctx, id, governors[1], false)
+	require.True(errors.Is(err, types.ErrAlreadyVoted), err)
+
+	// the governor changes their mind
+	require.NoError(k.Vote(ctx, id, governors[1], true))
+	require.True(s.hasEvent("proposal_vote_changed"))
+	require.True(k.GetAgreed(ctx, id).Contains(governors[1]))
+	require.False(k.GetDisagreed(ctx, id).Contains(governors[1]))
+	_, ok := k.GetProposal(ctx, id)
+	require.True(ok)
+
+	err = k.Vote(ctx, id, governors[1], true)
+	require.True(errors.Is(err, types.ErrAlreadyVoted), err)
+
+	// the last vote completes the proposal, two thirds agreed, so it is executed at once
+	require.NoError(k.Vote(ctx, id, governors[2], false))
+	_, ok = k.GetProposal(ctx, id)
+	require.False(ok)
+
+	record, err := k.History.Get(ctx, id)
+	require.NoError(err)
+	require.Equal(types.PROPOSAL_STATUS_PASSED, record.Proposal.Status)
+	require.Equal(types.EXECUTION_STATUS_SUCCEEDED, record.ExecutionStatus)
+	require.Equal([]string{governors[0].String(), governors[1].String()}, record.Agreed)
+	require.Equal([]string{governors[2].String()}, record.Disagreed)
+	require.True(k.GetGovernment(ctx).Contains(newcomer))
+}
+
+func (s *KeeperTestSuite) TestExpiredProposal() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	id := s.propose()
+	proposal, ok := k.GetProposal(ctx, id)
+	require.True(ok)
+
+	// the proposal is still open at its end time
+	require.NoError(k.BeginBlock(ctx.WithBlockTime(*proposal.EndTime)))
+	_, ok = k.GetProposal(ctx, id)
+	require.True(ok)
+
+	// the quorum is not reached
+	require.NoError(k.BeginBlock(ctx.WithBlockTime(proposal.EndTime.Add(time.Second))))
+	_, ok = k.GetProposal(ctx, id)
+	require.False(ok)
+
+	record, err := k.History.Get(ctx, id)
+	require.NoError(err)
+	require.Equal(types.PROPOSAL_STATUS_REJECTED, record.Proposal.Status)
+	require.False(k.GetGovernment(ctx).Contains(newcomer))
+}
+
+// queue approves a proposal with the execution delay of 10 minutes.
+func (s *KeeperTestSuite) queue() types.QueuedProposal {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	params := k.GetParams(ctx)
+	params.ExecutionDelay = 10
+	params.VetoShare = util.NewFraction(2, 3)
+	k.SetParams(ctx, params)
+
+	id := s.propose()
+	require.NoError(k.Vote(ctx, id, governors[1], true))
+	require.NoError(k.Vote(ctx, id, governors[2], true))
+	require.True(s.hasEvent("proposal_queued"))
+
+	_, ok := k.GetProposal(ctx, id)
+	require.False(ok)
+	_, err := k.History.Get(ctx, id)
+	require.Error(err)
+
+	queued, ok := k.GetQueuedProposal(ctx, id)
+	require.True(ok)
+	require.Equal(types.PROPOSAL_STATUS_QUEUED, queued.Record.Proposal.Status)
+	require.True(ctx.BlockTime().Add(10*time.Minute).Equal(queued.Eta), queued.Eta)
+	require.Len(k.GetQueue(ctx), 1)
+	// nothing is executed before the delay passes
+	require.False(k.GetGovernment(ctx).Contains(newcomer))
+	return queued
+}
+
+func (s *KeeperTestSuite) TestExecutionQueue() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	queued := s.queue()
+	id := queued.Record.Proposal.Id
+
+	require.NoError(k.BeginBlock(ctx.WithBlockTime(queued.Eta.Add(-time.Minute))))
+	_, ok := k.GetQueuedProposal(ctx, id)
+	require.True(ok)
+	require.False(k.GetGovernment(ctx).Contains(newcomer))
+
+	require.NoError(k.BeginBlock(ctx.WithBlockTime(queued.Eta.Add(time.Second))))
+	_, ok = k.GetQueuedProposal(ctx, id)
+	require.False(ok)
+	require.Empty(k.GetQueue(ctx))
+	require.True(s.hasEvent("proposal_executed"))
+
+	record, err := k.History.Get(ctx, id)
+	require.NoError(err)
+	require.Equal(types.PROPOSAL_STATUS_PASSED, record.Proposal.Status)
+	require.Equal(types.EXECUTION_STATUS_SUCCEEDED, record.ExecutionStatus)
+	require.True(k.GetGovernment(ctx).Contains(newcomer))
+}
+
+func (s *KeeperTestSuite) TestVeto() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	queued := s.queue()
+	id := queued.Record.Proposal.Id
+
+	err := k.Veto(ctx, id+1, governors[1])
+	require.True(errors.Is(err, types.ErrProposalNotFound), err)
+	err = k.Veto(ctx, id, newcomer)
+	require.True(errors.Is(err, types.ErrSignerNotAllowed), err)
+
+	// one veto of three is below the veto share of two thirds
+	require.NoError(k.Veto(ctx, id, governors[1]))
+	require.True(s.hasEvent("proposal_veto"))
+	queued, ok := k.GetQueuedProposal(ctx, id)
+	require.True(ok)
+	require.Equal([]string{governors[1].String()}, queued.Vetoed)
+
+	err = k.Veto(ctx, id, governors[1])
+	require.True(errors.Is(err, types.ErrAlreadyVoted), err)
+
+	require.NoError(k.Veto(ctx, id, governors[2]))
+	require.True(s.hasEvent("proposal_vetoed"))
+	_, ok = k.GetQueuedProposal(ctx, id)
+	require.False(ok)
+	require.Empty(k.GetQueue(ctx))
+
+	record, err := k.History.Get(ctx, id)
+	require.NoError(err)
+	require.Equal(types.PROPOSAL_STATUS_VETOED, record.Proposal.Status)
+	require.Equal(types.EXECUTION_STATUS_NOT_EXECUTED, record.ExecutionStatus)
+
+	// a vetoed proposal is never executed
+	require.NoError(k.BeginBlock(ctx.WithBlockTime(queued.Eta.Add(time.Second))))
+	require.False(k.GetGovernment(ctx).Contains(newcomer))
+}
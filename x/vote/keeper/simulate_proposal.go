@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/pkg/errors"
+
+	"github.com/axiome-pro/axm-node/x/vote/types"
+)
+
+// MaxSimulateProposalGas caps the gas a proposal simulation consumes, all of its messages together. Queries run with
+// an infinite gas meter unless the node sets a query gas limit.
+const MaxSimulateProposalGas uint64 = 30_000_000
+
+// SimulateProposal runs the messages one by one against a branched context the same way an approved proposal is
+// executed, and reports the outcome of each. Once a message fails, the rest are not run, as a real execution would
+// be reverted at that point. Every message is limited by the gas left of MaxSimulateProposalGas, a message running
+// out of it fails. Nothing is persisted.
+func (k Keeper) SimulateProposal(ctx sdk.Context, anys []*codectypes.Any) ([]types.MsgSimulationResult, error) {
+	if err := sdktx.UnpackInterfaces(k.cdc.InterfaceRegistry(), anys); err != nil {
+		return nil, errors.Wrap(err, "cannot unpack messages")
+	}
+	messages, err := sdktx.GetMsgs(anys, "SimulateProposal")
+	if err != nil {
+		return nil, err
+	}
+
+	budget := MaxSimulateProposalGas
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < budget {
+		budget = remaining
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	results := make([]types.MsgSimulationResult, len(messages))
+	failed := -1
+	for i, msg := range messages {
+		results[i].TypeUrl = sdk.MsgTypeURL(msg)
+		if failed >= 0 {
+			results[i].Error = fmt.Sprintf("not run: message #%d failed", failed)
+			continue
+		}
+
+		if err := k.validateProposalMsg(msg); err != nil {
+			results[i].Error = err.Error()
+			failed = i
+			continue
+		}
+
+		gasMeter := storetypes.NewGasMeter(budget)
+		msgCtx := cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+		res, err := safeExecuteHandler(msgCtx, msg, k.router.Handler(msg))
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "simulate proposal msg")
+		budget -= gasMeter.GasConsumedToLimit()
+
+		results[i].GasUsed = gasMeter.GasConsumedToLimit()
+		if err != nil {
+			results[i].Error = err.Error()
+			failed = i
+			continue
+		}
+		results[i].Success = true
+		results[i].Events = res.GetEvents().ToABCIEvents()
+	}
+	return results, nil
+}
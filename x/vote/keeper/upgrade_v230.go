@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeInitParamsV230 sets the decision rule params introduced in v2.3.0 to the values matching the former
+// hardcoded rule: every governor has to vote and at least two thirds of them have to agree. Approved proposals keep
+// being executed at once.
+func (k Keeper) UpgradeInitParamsV230(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.Threshold.IsNullValue() {
+		params.Threshold = types.DefaultThreshold
+	}
+	if params.Quorum.IsNullValue() {
+		params.Quorum = types.DefaultQuorum
+	}
+	if params.VetoShare.IsNullValue() {
+		params.VetoShare = types.DefaultVetoShare
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.Params.Set(ctx, params)
+}
+
+// UpgradeProposalsV230 moves the proposals stored by the single proposal layout to the id keyed one. Finished
+// proposals are numbered in the order they were finished in, the open one (if any) gets the next number.
+func (k Keeper) UpgradeProposalsV230(ctx sdk.Context) error {
+	logger := k.Logger(ctx)
+	logger.Info("Starting vote proposals migration for v2.3.0 upgrade ...")
+
+	// Legacy history records are keyed by the finish height, which is encoded just like the new uint64 ids.
+	var history []types.ProposalHistoryRecord
+	if err := k.History.Walk(ctx, nil, func(_ uint64, record types.ProposalHistoryRecord) (stop bool, err error) {
+		history = append(history, record)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if err := k.History.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	var id uint64
+	for _, record := range history {
+		id++
+		record.Proposal.Id = id
+		record.Proposal.StartBlock = record.Started
+		// Legacy proposals passed only if every governor voted and at least two thirds agreed
+		gov, agreed, disagreed := len(record.Government), len(record.Agreed), len(record.Disagreed)
+		if gov == agreed+disagreed && agreed*3 >= gov*2 {
+			record.Proposal.Status = types.PROPOSAL_STATUS_PASSED
+		} else {
+			record.Proposal.Status = types.PROPOSAL_STATUS_REJECTED
+		}
+		k.AddProposalHistoryRecord(ctx, record)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyCurrentVote)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		var proposal types.Proposal
+		if err := k.cdc.Unmarshal(bz, &proposal); err != nil {
+			return err
+		}
+
+		id++
+		proposal.Id = id
+		proposal.Status = types.PROPOSAL_STATUS_VOTING
+		if bz, err := store.Get(types.KeyStartBlock); err != nil {
+			return err
+		} else if bz != nil {
+			proposal.StartBlock = int64(binary.BigEndian.Uint64(bz))
+		}
+
+		var agreed, disagreed types.Government
+		if bz, err := store.Get(types.KeyAgreedMembers); err != nil {
+			return err
+		} else if bz != nil {
+			k.cdc.MustUnmarshal(bz, &agreed)
+		}
+		if bz, err := store.Get(types.KeyDisagreedMembers); err != nil {
+			return err
+		} else if bz != nil {
+			k.cdc.MustUnmarshal(bz, &disagreed)
+		}
+
+		k.SetProposal(ctx, proposal)
+		k.SetAgreed(ctx, id, agreed)
+		k.SetDisagreed(ctx, id, disagreed)
+		logger.Info("Migrated open proposal", "id", id, "name", proposal.Name)
+	}
+
+	for _, key := range [][]byte{
+		types.KeyCurrentVote, types.KeyStartBlock, types.KeyAgreedMembers, types.KeyDisagreedMembers,
+	} {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	k.SetNextProposalID(ctx, id+1)
+
+	logger.Info("... done", "proposals", id)
+	return nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	"github.com/axiome-pro/axm-node/x/vote/types"
+)
+
+func (s *KeeperTestSuite) TestUpgradeProposalsV230() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	gov := k.GetGovernment(ctx).Members
+	g0, g1, g2 := governors[0].String(), governors[1].String(), governors[2].String()
+
+	// legacy history records are keyed by the finish height
+	legacy := []types.ProposalHistoryRecord{
+		{ // everyone voted, two thirds agreed
+			Proposal:   types.Proposal{Name: "passed", Author: g0},
+			Government: gov,
+			Agreed:     []string{g0, g1},
+			Disagreed:  []string{g2},
+			Started:    90,
+			Finished:   100,
+		},
+		{ // two thirds agreed, but not everyone voted
+			Proposal:   types.Proposal{Name: "incomplete", Author: g1},
+			Government: gov,
+			Agreed:     []string{g0, g1},
+			Started:    150,
+			Finished:   200,
+		},
+		{ // everyone voted, one third agreed
+			Proposal:   types.Proposal{Name: "declined", Author: g2},
+			Government: gov,
+			Agreed:     []string{g2},
+			Disagreed:  []string{g0, g1},
+			Started:    210,
+			Finished:   220,
+		},
+	}
+	for _, record := range legacy {
+		require.NoError(k.History.Set(ctx, uint64(record.Finished), record))
+	}
+
+	// the open proposal
+	endTime := ctx.BlockTime().Add(time.Hour)
+	current := types.Proposal{Name: "open", Author: g0, EndTime: &endTime}
+	startBlock := make([]byte, 8)
+	binary.BigEndian.PutUint64(startBlock, 250)
+	agreed, disagreed := newGovernment(governors[0]), newGovernment(governors[1])
+
+	store := s.storeService.OpenKVStore(ctx)
+	require.NoError(store.Set(types.KeyCurrentVote, s.cdc.MustMarshal(&current)))
+	require.NoError(store.Set(types.KeyStartBlock, startBlock))
+	require.NoError(store.Set(types.KeyAgreedMembers, s.cdc.MustMarshal(&agreed)))
+	require.NoError(store.Set(types.KeyDisagreedMembers, s.cdc.MustMarshal(&disagreed)))
+
+	require.NoError(k.UpgradeProposalsV230(ctx))
+
+	// finished proposals are renumbered in the order they were finished in
+	history := k.GetHistory(ctx, 10, 1, types.PROPOSAL_STATUS_UNSPECIFIED)
+	require.Len(history, 3)
+	expected := []types.ProposalStatus{
+		types.PROPOSAL_STATUS_PASSED, types.PROPOSAL_STATUS_REJECTED, types.PROPOSAL_STATUS_REJECTED,
+	}
+	for i, record := range history {
+		require.Equal(uint64(i+1), record.Proposal.Id)
+		require.Equal(legacy[i].Proposal.Name, record.Proposal.Name)
+		require.Equal(legacy[i].Started, record.Proposal.StartBlock)
+		require.Equal(legacy[i].Finished, record.Finished)
+		require.Equal(expected[i], record.Proposal.Status, record.Proposal.Name)
+	}
+
+	// the open proposal gets the next id
+	proposal, ok := k.GetProposal(ctx, 4)
+	require.True(ok)
+	require.Equal("open", proposal.Name)
+	require.Equal(types.PROPOSAL_STATUS_VOTING, proposal.Status)
+	require.Equal(int64(250), proposal.StartBlock)
+	require.Equal(agreed.Members, k.GetAgreed(ctx, 4).Members)
+	require.Equal(disagreed.Members, k.GetDisagreed(ctx, 4).Members)
+	has, err := k.ProposalQueue.Has(ctx, collections.Join(endTime, uint64(4)))
+	require.NoError(err)
+	require.True(has)
+
+	require.Equal(uint64(5), k.GetNextProposalID(ctx))
+
+	for _, key := range [][]byte{
+		types.KeyCurrentVote, types.KeyStartBlock, types.KeyAgreedMembers, types.KeyDisagreedMembers,
+	} {
+		has, err := store.Has(key)
+		require.NoError(err)
+		require.False(has, key)
+	}
+}
+
+func (s *KeeperTestSuite) TestUpgradeProposalsV230Empty() {
+	ctx, k := s.ctx, s.voteKeeper
+	require := s.Require()
+
+	require.NoError(k.UpgradeProposalsV230(ctx))
+
+	require.Empty(k.GetHistory(ctx, 10, 1, types.PROPOSAL_STATUS_UNSPECIFIED))
+	require.Empty(k.GetActiveProposals(ctx))
+	require.Equal(uint64(1), k.GetNextProposalID(ctx))
+}
@@ -17,10 +17,12 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	modulev1 "github.com/axiome-pro/axm-node/api/axiome/vote/module/v1"
 	"github.com/axiome-pro/axm-node/x/vote/client/cli"
 	"github.com/axiome-pro/axm-node/x/vote/keeper"
+	"github.com/axiome-pro/axm-node/x/vote/simulation"
 	"github.com/axiome-pro/axm-node/x/vote/types"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -28,10 +30,11 @@ import (
 
 // TypeCode check to ensure the interface is properly implemented
 var (
-	_ appmodule.AppModule       = AppModule{}
-	_ appmodule.HasBeginBlocker = AppModule{}
-	_ module.HasGenesis         = AppModule{}
-	_ module.HasServices        = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasServices         = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
 
 	_ module.AppModuleBasic = AppModuleBasic{}
 )
@@ -101,6 +104,8 @@ type AppModule struct {
 
 	keeper         keeper.Keeper
 	referralKeeper types.ReferralKeeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
 }
 
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -110,11 +115,15 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 // NewAppModule creates a new AppModule object
 func NewAppModule(cdc codec.Codec, k keeper.Keeper,
 	referralKeeper types.ReferralKeeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         k,
 		referralKeeper: referralKeeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 	}
 }
 
@@ -160,6 +169,26 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, mrshl codec.JSONCodec) json.R
 //	return []abci.ValidatorUpdate{}
 //}
 
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenState of the vote module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for vote module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.ModuleName] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the vote module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, simState.TxConfig,
+		am.accountKeeper, am.bankKeeper, am.referralKeeper, am.keeper,
+	)
+}
+
 //
 // App Wiring Setup
 //
@@ -178,6 +207,8 @@ type ModuleInputs struct {
 	Cdc            codec.Codec
 	ReferralKeeper types.ReferralKeeper
 	AccountKeeper  types.AccountKeeper
+	BankKeeper     types.BankKeeper
+	StakingKeeper  types.StakingKeeper
 
 	MsgServiceRouter baseapp.MessageRouter
 }
@@ -203,9 +234,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		in.MsgServiceRouter,
 		in.AccountKeeper,
+		in.StakingKeeper,
+		in.BankKeeper,
 	)
 
-	m := NewAppModule(in.Cdc, k, in.ReferralKeeper)
+	m := NewAppModule(in.Cdc, k, in.ReferralKeeper, in.AccountKeeper, in.BankKeeper)
 
 	return ModuleOutputs{
 		VoteKeeper: k,
@@ -0,0 +1,132 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding vote type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyGovernment),
+			bytes.Equal(kvA.Key[:1], types.KeyProposalAgreed.Bytes()),
+			bytes.Equal(kvA.Key[:1], types.KeyProposalDisagreed.Bytes()):
+			var govA, govB types.Government
+			cdc.MustUnmarshal(kvA.Value, &govA)
+			cdc.MustUnmarshal(kvB.Value, &govB)
+			return fmt.Sprintf("%v\n%v", govA, govB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyProposals.Bytes()):
+			var proposalA, proposalB types.Proposal
+			cdc.MustUnmarshal(kvA.Value, &proposalA)
+			cdc.MustUnmarshal(kvB.Value, &proposalB)
+			return fmt.Sprintf("%v\n%v", proposalA, proposalB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyTimelocked.Bytes()):
+			var queuedA, queuedB types.QueuedProposal
+			cdc.MustUnmarshal(kvA.Value, &queuedA)
+			cdc.MustUnmarshal(kvB.Value, &queuedB)
+			return fmt.Sprintf("%v\n%v", queuedA, queuedB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyProposalSchedule.Bytes()),
+			bytes.Equal(kvA.Key[:1], types.KeyExecutionQueue.Bytes()):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+
+		case bytes.Equal(kvA.Key[:1], types.KeyProposalID.Bytes()),
+			bytes.Equal(kvA.Key[:1], types.KeyPollQueueID.Bytes()):
+			return fmt.Sprintf("nextIdA: %d\nnextIdB: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPollQueue.Bytes()):
+			var pollA, pollB types.Poll
+			cdc.MustUnmarshal(kvA.Value, &pollA)
+			cdc.MustUnmarshal(kvB.Value, &pollB)
+			return fmt.Sprintf("%v\n%v", pollA, pollB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyHistoryPrefix.Bytes()):
+			var recordA, recordB types.ProposalHistoryRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPollPrefix):
+			return decodePollStore(cdc, kvA, kvB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyParams.Bytes()):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		default:
+			panic(fmt.Sprintf("invalid vote key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
+
+// decodePollStore decodes the pairs stored under KeyPollPrefix.
+func decodePollStore(cdc codec.BinaryCodec, kvA, kvB kv.Pair) string {
+	key := kvA.Key[len(types.KeyPollPrefix):]
+	switch {
+	case bytes.Equal(key, types.KeyPollCurrent):
+		var pollA, pollB types.Poll
+		cdc.MustUnmarshal(kvA.Value, &pollA)
+		cdc.MustUnmarshal(kvB.Value, &pollB)
+		return fmt.Sprintf("%v\n%v", pollA, pollB)
+
+	case bytes.HasPrefix(key, types.KeyPollAnswers):
+		return fmt.Sprintf("answerA: %s\nanswerB: %s\nfor %s",
+			decodePollAnswer(kvA.Value), decodePollAnswer(kvB.Value), key[len(types.KeyPollAnswers):])
+
+	case bytes.Equal(key, types.KeyPollYesCount),
+		bytes.Equal(key, types.KeyPollNoCount),
+		bytes.HasPrefix(key, types.KeyPollOptionCount):
+		return fmt.Sprintf("countA: %d\ncountB: %d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+	case bytes.Equal(key, types.KeyPollWeightedYes),
+		bytes.Equal(key, types.KeyPollWeightedNo),
+		bytes.HasPrefix(key, types.KeyPollOptionWeight):
+		var sumA, sumB math.Int
+		if err := sumA.Unmarshal(kvA.Value); err != nil {
+			panic(err)
+		}
+		if err := sumB.Unmarshal(kvB.Value); err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf("weightSumA: %s\nweightSumB: %s", sumA, sumB)
+
+	case bytes.HasPrefix(key, types.KeyPollWeights):
+		var weightA, weightB math.Int
+		if err := weightA.Unmarshal(kvA.Value); err != nil {
+			panic(err)
+		}
+		if err := weightB.Unmarshal(kvB.Value); err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf("weightA: %s\nweightB: %s\nfor %s", weightA, weightB, key[len(types.KeyPollWeights):])
+
+	case bytes.HasPrefix(key, types.KeyPollHistory):
+		var itemA, itemB types.PollHistoryItem
+		cdc.MustUnmarshal(kvA.Value, &itemA)
+		cdc.MustUnmarshal(kvB.Value, &itemB)
+		return fmt.Sprintf("%v\n%v", itemA, itemB)
+
+	default:
+		panic(fmt.Sprintf("invalid vote poll key %X", kvA.Key))
+	}
+}
+
+// decodePollAnswer formats either a yes/no answer or an option index of a multiple-choice poll.
+func decodePollAnswer(value []byte) string {
+	if len(value) == 4 {
+		return fmt.Sprintf("option %d", binary.BigEndian.Uint32(value))
+	}
+	return fmt.Sprintf("%t", bytes.Equal(value, types.ValueYes))
+}
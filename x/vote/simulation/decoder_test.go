@@ -0,0 +1,85 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/x/vote/simulation"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var accAddr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+func TestDecodeStore(t *testing.T) {
+	cdc := testutil.MakeTestEncodingConfig().Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	gov := types.Government{}
+	gov.Append(accAddr1)
+	proposal := types.Proposal{Name: "test", Author: accAddr1.String(), Id: 1}
+	poll := types.Poll{Name: "test", Author: accAddr1.String(), Question: "test?"}
+	params := types.DefaultParams()
+	item := types.PollHistoryItem{Poll: poll, Yes: 1, Decision: types.DECISION_POSITIVE, WeightedYes: math.ZeroInt(), WeightedNo: math.ZeroInt()}
+
+	id := make([]byte, 8)
+	binary.BigEndian.PutUint64(id, 2)
+	count := make([]byte, 8)
+	binary.BigEndian.PutUint64(count, 3)
+	weight, err := math.NewInt(1000).Marshal()
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyGovernment, Value: cdc.MustMarshal(&gov)},
+			{Key: append(types.KeyProposals.Bytes(), id...), Value: cdc.MustMarshal(&proposal)},
+			{Key: types.KeyProposalID.Bytes(), Value: id},
+			{Key: append(types.KeyPollQueue.Bytes(), id...), Value: cdc.MustMarshal(&poll)},
+			{Key: types.KeyParams.Bytes(), Value: cdc.MustMarshal(&params)},
+			{Key: types.GetPollPrefixedKey(types.KeyPollCurrent), Value: cdc.MustMarshal(&poll)},
+			{Key: types.GetPollAnswersPrefixedKey(accAddr1), Value: types.ValueYes},
+			{Key: types.GetPollPrefixedKey(types.KeyPollYesCount), Value: count},
+			{Key: types.GetPollPrefixedKey(types.GetPollWeightKey(accAddr1)), Value: weight},
+			{Key: types.GetPollPrefixedKey(types.KeyPollWeightedYes), Value: weight},
+			{Key: types.GetPollPrefixedKey(types.KeyPollHistory), Value: cdc.MustMarshal(&item)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Government", fmt.Sprintf("%v\n%v", gov, gov)},
+		{"Proposal", fmt.Sprintf("%v\n%v", proposal, proposal)},
+		{"ProposalID/PollQueueID", "nextIdA: 2\nnextIdB: 2"},
+		{"PollQueue", fmt.Sprintf("%v\n%v", poll, poll)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"PollCurrent", fmt.Sprintf("%v\n%v", poll, poll)},
+		{"PollAnswers", fmt.Sprintf("answerA: true\nanswerB: true\nfor %s", accAddr1.Bytes())},
+		{"PollYesCount/PollNoCount/PollOptionCount", "countA: 3\ncountB: 3"},
+		{"PollWeights", fmt.Sprintf("weightA: 1000\nweightB: 1000\nfor %s", accAddr1.Bytes())},
+		{"PollWeightedYes/PollWeightedNo/PollOptionWeight", "weightSumA: 1000\nweightSumB: 1000"},
+		{"PollHistory", fmt.Sprintf("%v\n%v", item, item)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/axiome-pro/axm-node/util"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Simulation parameter constants
+const (
+	VotePeriod     = "vote_period"
+	PollPeriod     = "poll_period"
+	GovernmentSize = "government_size"
+	Threshold      = "threshold"
+	Quorum         = "quorum"
+	MsgRules       = "msg_rules"
+	ExecutionDelay = "execution_delay"
+	VetoShare      = "veto_share"
+
+	MinPollDeposit       = "min_poll_deposit"
+	MinPollParticipation = "min_poll_participation"
+)
+
+// GenVotePeriod randomized VotePeriod, short enough for proposals to expire during a simulation.
+func GenVotePeriod(r *rand.Rand) int32 {
+	return int32(simulation.RandIntBetween(r, 1, 60*24))
+}
+
+// GenPollPeriod randomized PollPeriod, short enough for polls to finish during a simulation.
+func GenPollPeriod(r *rand.Rand) int32 {
+	return int32(simulation.RandIntBetween(r, 1, 60*24))
+}
+
+// GenThreshold randomized Threshold, a share of votes between a simple majority and unanimity.
+func GenThreshold(r *rand.Rand) util.Fraction {
+	return util.Percent(int64(simulation.RandIntBetween(r, 51, 101)))
+}
+
+// GenQuorum randomized Quorum
+func GenQuorum(r *rand.Rand) util.Fraction {
+	return util.Percent(int64(simulation.RandIntBetween(r, 1, 101)))
+}
+
+// GenMsgRules randomized MsgRules. Government changes may require unanimity, params updates may need a simple
+// majority.
+func GenMsgRules(r *rand.Rand) (rules []types.MsgRule) {
+	if r.Intn(2) == 0 {
+		for _, msg := range []sdk.Msg{&types.MsgAddGovernor{}, &types.MsgRemoveGovernor{}} {
+			rules = append(rules, types.MsgRule{
+				MsgTypeUrl: sdk.MsgTypeURL(msg),
+				Threshold:  util.FractionInt(1),
+				Quorum:     util.FractionInt(1),
+			})
+		}
+	}
+	if r.Intn(2) == 0 {
+		rules = append(rules, types.MsgRule{
+			MsgTypeUrl: sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+			Threshold:  util.Percent(51),
+			Quorum:     GenQuorum(r),
+		})
+	}
+	return rules
+}
+
+// GenExecutionDelay randomized ExecutionDelay, half of the time approved proposals are executed at once.
+func GenExecutionDelay(r *rand.Rand) int32 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return int32(simulation.RandIntBetween(r, 1, 60*24))
+}
+
+// GenVetoShare randomized VetoShare
+func GenVetoShare(r *rand.Rand) util.Fraction {
+	return util.Percent(int64(simulation.RandIntBetween(r, 1, 101)))
+}
+
+// GenMinPollDeposit randomized MinPollDeposit, half of the time only governors can start polls.
+func GenMinPollDeposit(r *rand.Rand, bondDenom string) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, int64(simulation.RandIntBetween(r, 1, 1_000_000))))
+}
+
+// GenMinPollParticipation randomized MinPollParticipation, low enough for some deposits to be refunded.
+func GenMinPollParticipation(r *rand.Rand) uint64 {
+	return uint64(r.Intn(5))
+}
+
+// GenGovernmentSize randomized number of governors. It is kept small, so that proposals often get votes from every
+// governor before they expire.
+func GenGovernmentSize(r *rand.Rand, accounts int) int {
+	return simulation.RandIntBetween(r, 1, min(accounts, 10)+1)
+}
+
+// RandomizedGenState generates a random GenesisState for vote
+func RandomizedGenState(simState *module.SimulationState) {
+	var votePeriod int32
+	simState.AppParams.GetOrGenerate(VotePeriod, &votePeriod, simState.Rand, func(r *rand.Rand) { votePeriod = GenVotePeriod(r) })
+
+	var pollPeriod int32
+	simState.AppParams.GetOrGenerate(PollPeriod, &pollPeriod, simState.Rand, func(r *rand.Rand) { pollPeriod = GenPollPeriod(r) })
+
+	var governmentSize int
+	simState.AppParams.GetOrGenerate(GovernmentSize, &governmentSize, simState.Rand, func(r *rand.Rand) {
+		governmentSize = GenGovernmentSize(r, len(simState.Accounts))
+	})
+
+	var threshold util.Fraction
+	simState.AppParams.GetOrGenerate(Threshold, &threshold, simState.Rand, func(r *rand.Rand) { threshold = GenThreshold(r) })
+
+	var quorum util.Fraction
+	simState.AppParams.GetOrGenerate(Quorum, &quorum, simState.Rand, func(r *rand.Rand) { quorum = GenQuorum(r) })
+
+	var msgRules []types.MsgRule
+	simState.AppParams.GetOrGenerate(MsgRules, &msgRules, simState.Rand, func(r *rand.Rand) { msgRules = GenMsgRules(r) })
+
+	var executionDelay int32
+	simState.AppParams.GetOrGenerate(ExecutionDelay, &executionDelay, simState.Rand, func(r *rand.Rand) {
+		executionDelay = GenExecutionDelay(r)
+	})
+
+	var vetoShare util.Fraction
+	simState.AppParams.GetOrGenerate(VetoShare, &vetoShare, simState.Rand, func(r *rand.Rand) { vetoShare = GenVetoShare(r) })
+
+	var minPollDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(MinPollDeposit, &minPollDeposit, simState.Rand, func(r *rand.Rand) {
+		minPollDeposit = GenMinPollDeposit(r, simState.BondDenom)
+	})
+
+	var minPollParticipation uint64
+	simState.AppParams.GetOrGenerate(MinPollParticipation, &minPollParticipation, simState.Rand, func(r *rand.Rand) {
+		minPollParticipation = GenMinPollParticipation(r)
+	})
+
+	gov := types.Government{}
+	for _, i := range simState.Rand.Perm(len(simState.Accounts))[:governmentSize] {
+		gov.Append(simState.Accounts[i].Address)
+	}
+
+	params := types.NewParams(
+		votePeriod, pollPeriod, threshold, quorum, msgRules, executionDelay, vetoShare,
+		minPollDeposit, minPollParticipation,
+	)
+
+	voteGenesis := types.NewGenesisState(params, gov, nil, 1, nil, nil)
+
+	bz, err := json.MarshalIndent(&voteGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated vote parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(voteGenesis)
+}
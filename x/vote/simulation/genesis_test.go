@@ -0,0 +1,91 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/axiome-pro/axm-node/x/vote/simulation"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+// Abonormal scenarios are not tested here.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var voteGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &voteGenesis)
+
+	require.NoError(t, types.ValidateGenesis(voteGenesis))
+	require.Equal(t, uint64(1), voteGenesis.NextProposalId)
+	require.Empty(t, voteGenesis.Proposals)
+	require.Empty(t, voteGenesis.Queue)
+	require.Empty(t, voteGenesis.History)
+	// the government is picked from the simulation accounts
+	require.NotEmpty(t, voteGenesis.Government)
+	require.LessOrEqual(t, len(voteGenesis.Government), 3)
+	for _, member := range voteGenesis.Government {
+		found := false
+		for _, acc := range simState.Accounts {
+			found = found || acc.Address.String() == member
+		}
+		require.True(t, found, member)
+	}
+	for _, coin := range voteGenesis.Params.MinPollDeposit {
+		require.Equal(t, sdk.DefaultBondDenom, coin.Denom)
+	}
+}
+
+// TestRandomizedGenState1 tests abnormal scenarios of applying RandomizedGenState.
+func TestRandomizedGenState1(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	// all these tests will panic
+	tests := []struct {
+		simState module.SimulationState
+		panicMsg string
+	}{
+		{ // panic => reason: incomplete initialization of the simState
+			module.SimulationState{}, "invalid memory address or nil pointer dereference"},
+		{ // panic => reason: no accounts to form the government from
+			module.SimulationState{
+				AppParams: make(simtypes.AppParams),
+				Cdc:       cdc,
+				Rand:      r,
+			}, "invalid argument to Intn"},
+	}
+
+	for _, tt := range tests {
+		require.Panicsf(t, func() { simulation.RandomizedGenState(&tt.simState) }, tt.panicMsg)
+	}
+}
@@ -0,0 +1,551 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/axiome-pro/axm-node/util"
+	referral "github.com/axiome-pro/axm-node/x/referral/types"
+	"github.com/axiome-pro/axm-node/x/vote/keeper"
+	"github.com/axiome-pro/axm-node/x/vote/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgPropose        = "op_weight_msg_propose"
+	OpWeightMsgVote           = "op_weight_msg_vote"
+	OpWeightMsgVeto           = "op_weight_msg_veto"
+	OpWeightMsgCancelProposal = "op_weight_msg_cancel_proposal"
+	OpWeightMsgAmendProposal  = "op_weight_msg_amend_proposal"
+	OpWeightMsgAddGovernor    = "op_weight_msg_add_governor"
+	OpWeightMsgRemoveGovernor = "op_weight_msg_remove_governor"
+	OpWeightMsgStartPoll      = "op_weight_msg_start_poll"
+	OpWeightMsgAnswerPoll     = "op_weight_msg_answer_poll"
+
+	DefaultWeightMsgPropose        = 20
+	DefaultWeightMsgVote           = 100
+	DefaultWeightMsgVeto           = 10
+	DefaultWeightMsgCancelProposal = 5
+	DefaultWeightMsgAmendProposal  = 5
+	DefaultWeightMsgAddGovernor    = 20
+	DefaultWeightMsgRemoveGovernor = 20
+	DefaultWeightMsgStartPoll      = 20
+	DefaultWeightMsgAnswerPoll     = 100
+)
+
+// proposalMsgGenerator generates a message to be executed by a proposal. It returns nil if no message fits the
+// current state.
+type proposalMsgGenerator func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k keeper.Keeper) sdk.Msg
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	rk types.ReferralKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgPropose        int
+		weightMsgVote           int
+		weightMsgVeto           int
+		weightMsgCancelProposal int
+		weightMsgAmendProposal  int
+		weightMsgAddGovernor    int
+		weightMsgRemoveGovernor int
+		weightMsgStartPoll      int
+		weightMsgAnswerPoll     int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgPropose, &weightMsgPropose, nil, func(_ *rand.Rand) {
+		weightMsgPropose = DefaultWeightMsgPropose
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgVote, &weightMsgVote, nil, func(_ *rand.Rand) {
+		weightMsgVote = DefaultWeightMsgVote
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgVeto, &weightMsgVeto, nil, func(_ *rand.Rand) {
+		weightMsgVeto = DefaultWeightMsgVeto
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgCancelProposal, &weightMsgCancelProposal, nil, func(_ *rand.Rand) {
+		weightMsgCancelProposal = DefaultWeightMsgCancelProposal
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgAmendProposal, &weightMsgAmendProposal, nil, func(_ *rand.Rand) {
+		weightMsgAmendProposal = DefaultWeightMsgAmendProposal
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgAddGovernor, &weightMsgAddGovernor, nil, func(_ *rand.Rand) {
+		weightMsgAddGovernor = DefaultWeightMsgAddGovernor
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgRemoveGovernor, &weightMsgRemoveGovernor, nil, func(_ *rand.Rand) {
+		weightMsgRemoveGovernor = DefaultWeightMsgRemoveGovernor
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgStartPoll, &weightMsgStartPoll, nil, func(_ *rand.Rand) {
+		weightMsgStartPoll = DefaultWeightMsgStartPoll
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgAnswerPoll, &weightMsgAnswerPoll, nil, func(_ *rand.Rand) {
+		weightMsgAnswerPoll = DefaultWeightMsgAnswerPoll
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgPropose,
+			SimulateMsgPropose(txGen, ak, bk, k, genMsgUpdateParams),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVote,
+			SimulateMsgVote(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVeto,
+			SimulateMsgVeto(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelProposal,
+			SimulateMsgCancelProposal(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAmendProposal,
+			SimulateMsgAmendProposal(txGen, ak, bk, k, genMsgUpdateParams),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddGovernor,
+			SimulateMsgPropose(txGen, ak, bk, k, genMsgAddGovernor),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveGovernor,
+			SimulateMsgPropose(txGen, ak, bk, k, genMsgRemoveGovernor),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgStartPoll,
+			SimulateMsgStartPoll(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAnswerPoll,
+			SimulateMsgAnswerPoll(txGen, ak, bk, rk, k),
+		),
+	}
+}
+
+// SimulateMsgPropose generates a MsgPropose from a random governor, carrying a message made by genMsg.
+func SimulateMsgPropose(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	genMsg proposalMsgGenerator,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgPropose{})
+
+		author, ok := randomGovernor(r, ctx, accs, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no governor among accounts"), nil, nil
+		}
+
+		proposalMsg := genMsg(r, ctx, accs, k)
+		if proposalMsg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no message to propose"), nil, nil
+		}
+
+		msg, err := types.NewMsgPropose([]sdk.Msg{proposalMsg}, author.Address.String(), simtypes.RandStringOfLength(r, 10))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create proposal"), nil, err
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, author, msg)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote for a random active proposal. Mostly it comes from a governor that has not voted
+// for it yet, sometimes a governor changes the vote given before.
+func SimulateMsgVote(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgVote{})
+
+		proposals := k.GetActiveProposals(ctx)
+		if len(proposals) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active proposal"), nil, nil
+		}
+
+		var (
+			proposal  = proposals[r.Intn(len(proposals))]
+			agreed    = proposal.GetAgreed()
+			disagreed = proposal.GetDisagreed()
+			voters    []simtypes.Account
+			voted     []simtypes.Account
+		)
+		for _, acc := range governors(ctx, accs, k) {
+			if agreed.Contains(acc.Address) || disagreed.Contains(acc.Address) {
+				voted = append(voted, acc)
+			} else {
+				voters = append(voters, acc)
+			}
+		}
+
+		if len(voted) != 0 && (len(voters) == 0 || r.Intn(10) == 0) {
+			voter := voted[r.Intn(len(voted))]
+			msg := &types.MsgVote{
+				Voter:      voter.Address.String(),
+				Agree:      !agreed.Contains(voter.Address),
+				ProposalId: proposal.Proposal.Id,
+			}
+			return deliver(r, app, ctx, txGen, ak, bk, voter, msg)
+		}
+		if len(voters) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no governor among accounts"), nil, nil
+		}
+		voter := voters[r.Intn(len(voters))]
+
+		msg := &types.MsgVote{
+			Voter:      voter.Address.String(),
+			Agree:      r.Intn(4) != 0,
+			ProposalId: proposal.Proposal.Id,
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, voter, msg)
+	}
+}
+
+// SimulateMsgVeto generates a MsgVeto for a random queued proposal from a governor that has not vetoed it yet.
+func SimulateMsgVeto(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgVeto{})
+
+		queue := k.GetQueue(ctx)
+		if len(queue) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no queued proposal"), nil, nil
+		}
+
+		var (
+			queued  = queue[r.Intn(len(queue))]
+			vetoed  = queued.GetVetoed()
+			vetoers []simtypes.Account
+		)
+		for _, acc := range governors(ctx, accs, k) {
+			if !vetoed.Contains(acc.Address) {
+				vetoers = append(vetoers, acc)
+			}
+		}
+		if len(vetoers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "every governor has vetoed"), nil, nil
+		}
+		governor := vetoers[r.Intn(len(vetoers))]
+
+		msg := &types.MsgVeto{
+			Governor:   governor.Address.String(),
+			ProposalId: queued.Record.Proposal.Id,
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, governor, msg)
+	}
+}
+
+// SimulateMsgCancelProposal generates a MsgCancelProposal for a random active proposal nobody but its author has
+// voted for.
+func SimulateMsgCancelProposal(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCancelProposal{})
+
+		var candidates []types.ActiveProposal
+		for _, proposal := range k.GetActiveProposals(ctx) {
+			onlyAuthor := len(proposal.Agreed) == 0 ||
+				(len(proposal.Agreed) == 1 && proposal.Agreed[0] == proposal.Proposal.Author)
+			if len(proposal.Disagreed) == 0 && onlyAuthor {
+				candidates = append(candidates, proposal)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no proposal to cancel"), nil, nil
+		}
+		proposal := candidates[r.Intn(len(candidates))].Proposal
+
+		author, ok := proposalAuthor(accs, proposal)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "author not among accounts"), nil, nil
+		}
+
+		msg := &types.MsgCancelProposal{
+			Author:     author.Address.String(),
+			ProposalId: proposal.Id,
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, author, msg)
+	}
+}
+
+// SimulateMsgAmendProposal generates a MsgAmendProposal for a random active proposal, replacing its messages with
+// one made by genMsg.
+func SimulateMsgAmendProposal(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	genMsg proposalMsgGenerator,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAmendProposal{})
+
+		proposals := k.GetActiveProposals(ctx)
+		if len(proposals) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active proposal"), nil, nil
+		}
+		proposal := proposals[r.Intn(len(proposals))].Proposal
+
+		author, ok := proposalAuthor(accs, proposal)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "author not among accounts"), nil, nil
+		}
+
+		proposalMsg := genMsg(r, ctx, accs, k)
+		if proposalMsg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no message to propose"), nil, nil
+		}
+
+		msg, err := types.NewMsgAmendProposal([]sdk.Msg{proposalMsg}, author.Address.String(), proposal.Id, "")
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create amendment"), nil, err
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, author, msg)
+	}
+}
+
+// SimulateMsgStartPoll generates a MsgStartPoll with a random status requirement and weighting. Half of the polls are
+// multiple-choice. The author is a random account, it escrows the poll deposit if it is not a governor.
+func SimulateMsgStartPoll(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgStartPoll{})
+
+		if len(k.GetPollQueue(ctx)) != 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "poll queue is not empty"), nil, nil
+		}
+
+		var deposit sdk.Coins
+		author, _ := simtypes.RandomAcc(r, accs)
+		if !k.GetGovernment(ctx).Contains(author.Address) {
+			deposit = k.GetParams(ctx).MinPollDeposit
+			if deposit.IsZero() {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "only governors can start polls"), nil, nil
+			}
+			if !bk.SpendableCoins(ctx, author.Address).IsAllGTE(deposit) {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds for the deposit"), nil, nil
+			}
+		}
+
+		minStatus := referral.Status(simtypes.RandIntBetween(r, int(referral.MinimumStatus), int(referral.MaximumStatus)+1))
+		poll := types.NewPollStatus(
+			author.Address,
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			util.Percent(int64(r.Intn(101))),
+			minStatus,
+		)
+		// Voting power weighting is not applicable to status polls
+		poll.Weighting = types.PollWeighting(r.Intn(int(types.POLL_WEIGHTING_VOTING_POWER)))
+		if r.Intn(2) == 0 {
+			poll.Options = make([]string, simtypes.RandIntBetween(r, 2, 6))
+			for i := range poll.Options {
+				poll.Options[i] = simtypes.RandStringOfLength(r, 10)
+			}
+		}
+		msg := &types.MsgStartPoll{
+			Poll:   poll,
+			Author: author.Address.String(),
+		}
+
+		return deliverSpending(r, app, ctx, txGen, ak, bk, author, msg, deposit)
+	}
+}
+
+// SimulateMsgAnswerPoll generates a MsgAnswerPoll from a random account allowed to answer the current poll.
+func SimulateMsgAnswerPoll(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	rk types.ReferralKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAnswerPoll{})
+
+		poll, ok := k.GetCurrentPoll(ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active poll"), nil, nil
+		}
+
+		answered := make(map[string]bool)
+		if err := k.IterateThroughCurrentPollAnswers(ctx, func(ans types.PollAnswer) (stop bool) {
+			answered[ans.Acc] = true
+			return false
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get poll answers"), nil, err
+		}
+
+		respondent, _ := simtypes.RandomAcc(r, accs)
+		if answered[respondent.Address.String()] {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account has already answered"), nil, nil
+		}
+		if req, ok := poll.Requirements.(*types.Poll_MinStatus); ok {
+			info, err := rk.Get(ctx, respondent.Address.String())
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get referral info"), nil, err
+			}
+			if info.Status < req.MinStatus {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "account status is too low"), nil, nil
+			}
+		}
+
+		msg := &types.MsgAnswerPoll{
+			Respondent: respondent.Address.String(),
+			Yes:        r.Intn(2) == 0,
+		}
+		if poll.IsMultipleChoice() {
+			msg.Option = uint32(r.Intn(len(poll.Options)))
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, respondent, msg)
+	}
+}
+
+func genMsgUpdateParams(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account, k keeper.Keeper) sdk.Msg {
+	// The poll deposit is kept, its denom is unknown here
+	current := k.GetParams(ctx)
+	return &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params: types.NewParams(
+			GenVotePeriod(r), GenPollPeriod(r), GenThreshold(r), GenQuorum(r), GenMsgRules(r),
+			GenExecutionDelay(r), GenVetoShare(r), current.MinPollDeposit, GenMinPollParticipation(r),
+		),
+	}
+}
+
+func genMsgAddGovernor(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k keeper.Keeper) sdk.Msg {
+	gov := k.GetGovernment(ctx)
+	for _, i := range r.Perm(len(accs)) {
+		if !gov.Contains(accs[i].Address) {
+			return &types.MsgAddGovernor{
+				Authority: k.GetAuthority(),
+				Governor:  accs[i].Address.String(),
+			}
+		}
+	}
+	return nil
+}
+
+func genMsgRemoveGovernor(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account, k keeper.Keeper) sdk.Msg {
+	gov := k.GetGovernment(ctx)
+	if len(gov.Members) < 2 {
+		return nil
+	}
+	return &types.MsgRemoveGovernor{
+		Authority: k.GetAuthority(),
+		Governor:  gov.Members[r.Intn(len(gov.Members))],
+	}
+}
+
+// governors returns the simulation accounts being members of the government.
+func governors(ctx sdk.Context, accs []simtypes.Account, k keeper.Keeper) (res []simtypes.Account) {
+	gov := k.GetGovernment(ctx)
+	for _, acc := range accs {
+		if gov.Contains(acc.Address) {
+			res = append(res, acc)
+		}
+	}
+	return res
+}
+
+// proposalAuthor returns the simulation account that has authored the proposal.
+func proposalAuthor(accs []simtypes.Account, proposal types.Proposal) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(proposal.Author)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+func randomGovernor(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k keeper.Keeper) (simtypes.Account, bool) {
+	govs := governors(ctx, accs, k)
+	if len(govs) == 0 {
+		return simtypes.Account{}, false
+	}
+	return govs[r.Intn(len(govs))], true
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return deliverSpending(r, app, ctx, txGen, ak, bk, simAccount, msg, sdk.NewCoins())
+}
+
+// deliverSpending delivers the message leaving the coins it spends out of the fees.
+func deliverSpending(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spent,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -13,6 +13,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// Messages
 	cdc.RegisterConcrete(MsgPropose{}, ModuleName+"/CreateProposal", nil)
 	cdc.RegisterConcrete(MsgVote{}, ModuleName+"/ProposalVote", nil)
+	cdc.RegisterConcrete(MsgVeto{}, ModuleName+"/ProposalVeto", nil)
+	cdc.RegisterConcrete(MsgCancelProposal{}, ModuleName+"/CancelProposal", nil)
+	cdc.RegisterConcrete(MsgAmendProposal{}, ModuleName+"/AmendProposal", nil)
 	cdc.RegisterConcrete(MsgStartPoll{}, ModuleName+"/StartPoll", nil)
 	cdc.RegisterConcrete(MsgAnswerPoll{}, ModuleName+"/AnswerPoll", nil)
 	// Other
@@ -24,6 +27,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPropose{},
 		&MsgVote{},
+		&MsgVeto{},
+		&MsgCancelProposal{},
+		&MsgAmendProposal{},
 		&MsgStartPoll{},
 		&MsgAnswerPoll{},
 		&Proposal{},
@@ -17,4 +17,9 @@ var (
 	ErrRespondentNotAllowed      = sdkerrors.Register(ModuleName, 9, "poll requirements don't match")
 	ErrInvalidSigner             = sdkerrors.Register(ModuleName, 10, "invalid signer for proposed message")
 	ErrUnroutableProposalMsg     = sdkerrors.Register(ModuleName, 11, "no route for proposed message")
+	ErrProposalNotFound          = sdkerrors.Register(ModuleName, 12, "proposal not found")
+	ErrNotProposalAuthor         = sdkerrors.Register(ModuleName, 13, "signer is not the proposal author")
+	ErrProposalHasVotes          = sdkerrors.Register(ModuleName, 14, "proposal already has votes")
+	ErrUnknownPollOption         = sdkerrors.Register(ModuleName, 15, "no such poll option")
+	ErrPollQueueFull             = sdkerrors.Register(ModuleName, 16, "too many polls waiting to start")
 )
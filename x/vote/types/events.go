@@ -4,4 +4,22 @@ func (EventProposalCreated) XXX_MessageName() string { return "proposal_created"
 
 func (EventProposalVote) XXX_MessageName() string { return "proposal_vote" }
 
+func (EventProposalVoteChanged) XXX_MessageName() string { return "proposal_vote_changed" }
+
 func (EventvoteFinished) XXX_MessageName() string { return "vote_finished" }
+
+func (EventProposalQueued) XXX_MessageName() string { return "proposal_queued" }
+
+func (EventProposalExecuted) XXX_MessageName() string { return "proposal_executed" }
+
+func (EventProposalCancelled) XXX_MessageName() string { return "proposal_cancelled" }
+
+func (EventProposalAmended) XXX_MessageName() string { return "proposal_amended" }
+
+func (EventProposalVeto) XXX_MessageName() string { return "proposal_veto" }
+
+func (EventProposalVetoed) XXX_MessageName() string { return "proposal_vetoed" }
+
+func (EventPollStarted) XXX_MessageName() string { return "poll_started" }
+
+func (EventPollAnswered) XXX_MessageName() string { return "poll_answered" }
@@ -6,14 +6,33 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"context"
+
+	"cosmossdk.io/math"
+	stakingtypes "github.com/axiome-pro/axm-node/x/staking/types"
 )
 
 type ReferralKeeper interface {
 	Get(ctx sdk.Context, acc string) (referral.Info, error)
 }
 
+// StakingKeeper defines the expected staking keeper used to check validator-only polls
+type StakingKeeper interface {
+	Validator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.ValidatorI, error)
+	PowerReduction(ctx context.Context) math.Int
+}
+
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
 type AccountKeeper interface {
 	AddressCodec() address.Codec
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 }
+
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+
+	// used for simulations
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
@@ -9,23 +9,22 @@ import (
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:         DefaultParams(),
+		NextProposalId: 1,
 	}
 }
 
-func NewGenesisState(params Params, gov Government, current *Proposal, start int64, agreed, disagreed Government, history []ProposalHistoryRecord) *GenesisState {
-	var currentProposal Proposal
-	if current != nil {
-		currentProposal = *current
-	}
+func NewGenesisState(
+	params Params, gov Government, proposals []ActiveProposal, nextID uint64, queue []QueuedProposal,
+	history []ProposalHistoryRecord,
+) *GenesisState {
 	return &GenesisState{
-		Params:          params,
-		Government:      gov.Members,
-		CurrentProposal: currentProposal,
-		StartBlock:      start,
-		Agreed:          agreed.Members,
-		Disagreed:       disagreed.Members,
-		History:         history,
+		Params:         params,
+		Government:     gov.Members,
+		Proposals:      proposals,
+		NextProposalId: nextID,
+		Queue:          queue,
+		History:        history,
 	}
 }
 
@@ -42,38 +41,45 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return errors.Wrap(err, "invalid params")
 	}
-	if data.CurrentProposal.Equal(Proposal{}) {
-		if data.StartBlock != 0 {
-			return errors.New("invalid start_block: must be zero unless current_proposal is set")
-		}
-		if data.Agreed != nil && len(data.Agreed) != 0 {
-			return errors.New("invalid agreed: must be empty unless current_proposal is set")
+	if data.NextProposalId == 0 {
+		return errors.New("invalid next_proposal_id: must be positive")
+	}
+	ids := make(map[uint64]bool, len(data.Proposals)+len(data.Queue)+len(data.History))
+	for i, p := range data.Proposals {
+		if err := p.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid proposals (item #%d)", i)
 		}
-		if data.Disagreed != nil && len(data.Disagreed) != 0 {
-			return errors.New("invalid disagreed: must be empty unless current_proposal is set")
+		if p.Proposal.Id >= data.NextProposalId {
+			return errors.Errorf("invalid proposals (item #%d): id must be less than next_proposal_id", i)
 		}
-	} else {
-		if err := data.CurrentProposal.Validate(); err != nil {
-			return errors.Wrap(err, "invalid current_proposal")
+		if ids[p.Proposal.Id] {
+			return errors.Errorf("invalid proposals (item #%d): duplicate id %d", i, p.Proposal.Id)
 		}
-		if data.StartBlock <= 0 {
-			return errors.New("invalid start_block: must be positive as current_proposal is set")
+		ids[p.Proposal.Id] = true
+	}
+	for i, q := range data.Queue {
+		if err := q.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid queue (item #%d)", i)
 		}
-		for i, bech32 := range data.Agreed {
-			if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
-				return errors.Wrapf(err, "invalid agreed (item #%d)", i)
-			}
+		if q.Record.Proposal.Id >= data.NextProposalId {
+			return errors.Errorf("invalid queue (item #%d): id must be less than next_proposal_id", i)
 		}
-		for i, bech32 := range data.Disagreed {
-			if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
-				return errors.Wrapf(err, "invalid disagreed (item #%d)", i)
-			}
+		if ids[q.Record.Proposal.Id] {
+			return errors.Errorf("invalid queue (item #%d): duplicate id %d", i, q.Record.Proposal.Id)
 		}
+		ids[q.Record.Proposal.Id] = true
 	}
 	for i, r := range data.History {
 		if err := r.Validate(); err != nil {
 			return errors.Wrapf(err, "invalid history (item #%d)", i)
 		}
+		if r.Proposal.Id >= data.NextProposalId {
+			return errors.Errorf("invalid history (item #%d): id must be less than next_proposal_id", i)
+		}
+		if ids[r.Proposal.Id] {
+			return errors.Errorf("invalid history (item #%d): duplicate id %d", i, r.Proposal.Id)
+		}
+		ids[r.Proposal.Id] = true
 	}
 	return nil
 }
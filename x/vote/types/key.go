@@ -1,6 +1,10 @@
 package types
 
-import "cosmossdk.io/collections"
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/collections"
+)
 
 const (
 	// ModuleName is the name of the module
@@ -8,10 +12,15 @@ const (
 
 	// RouterKey to be used for routing msgs
 	RouterKey = ModuleName
+
+	// PollDepositAccountName is the module account escrowing the poll deposits until the polls end
+	PollDepositAccountName = "vote_poll_deposit"
 )
 
 var (
-	KeyGovernment       = []byte{0x01}
+	KeyGovernment = []byte{0x01}
+
+	// Legacy single proposal keys, only read by the v2.3.0 store migration.
 	KeyAgreedMembers    = []byte{0x02}
 	KeyDisagreedMembers = []byte{0x03}
 	KeyCurrentVote      = []byte{0x04}
@@ -19,7 +28,8 @@ var (
 	KeyTotalAgreed      = []byte{0x06}
 	KeyTotalDisagreed   = []byte{0x07}
 	KeyStartBlock       = []byte{0x08}
-	KeyHistoryPrefix    = []byte{0x09}
+
+	KeyHistoryPrefix = collections.NewPrefix([]byte{0x09})
 
 	KeyPollPrefix   = []byte{0x0A}
 	KeyPollCurrent  = []byte{0x0B}
@@ -27,10 +37,28 @@ var (
 	KeyPollYesCount = []byte{0x0D}
 	KeyPollNoCount  = []byte{0x0E}
 	KeyPollHistory  = []byte{0x0F}
+	// KeyPollOptionCount prefixes the answer counters of a multiple-choice poll, one per option index.
+	KeyPollOptionCount = []byte{0x19}
+	// KeyPollWeights prefixes the answer weights of a weighted poll snapshotted when the answers were given.
+	KeyPollWeights = []byte{0x1A}
+	// KeyPollWeightedYes and KeyPollWeightedNo keep the running sums of the answer weights of a weighted yes/no poll.
+	KeyPollWeightedYes = []byte{0x1D}
+	KeyPollWeightedNo  = []byte{0x1E}
+	// KeyPollOptionWeight prefixes the running sums of the answer weights of a weighted multiple-choice poll, one per
+	// option index.
+	KeyPollOptionWeight = []byte{0x1F}
 
-	KeyParams           = collections.NewPrefix([]byte{0x10})
-	KeyProposalSchedule = []byte{0x11}
-	KeyPollSchedule     = []byte{0x12}
+	KeyParams            = collections.NewPrefix([]byte{0x10})
+	KeyProposalSchedule  = collections.NewPrefix([]byte{0x11})
+	KeyPollSchedule      = []byte{0x12}
+	KeyProposals         = collections.NewPrefix([]byte{0x13})
+	KeyProposalAgreed    = collections.NewPrefix([]byte{0x14})
+	KeyProposalDisagreed = collections.NewPrefix([]byte{0x15})
+	KeyProposalID        = collections.NewPrefix([]byte{0x16})
+	KeyTimelocked        = collections.NewPrefix([]byte{0x17})
+	KeyExecutionQueue    = collections.NewPrefix([]byte{0x18})
+	KeyPollQueue         = collections.NewPrefix([]byte{0x1B})
+	KeyPollQueueID       = collections.NewPrefix([]byte{0x1C})
 
 	ValueYes = []byte{0x01}
 	ValueNo  = []byte{0x00}
@@ -43,3 +71,25 @@ func GetPollPrefixedKey(key []byte) []byte {
 func GetPollAnswersPrefixedKey(key []byte) []byte {
 	return append(GetPollPrefixedKey(KeyPollAnswers), key...)
 }
+
+// GetPollWeightKey returns the key (within KeyPollPrefix) of the answer weight snapshotted for the account.
+func GetPollWeightKey(acc []byte) []byte {
+	return append(append([]byte{}, KeyPollWeights...), acc...)
+}
+
+// GetPollOptionCountKey returns the key (within KeyPollPrefix) of the answer counter of a multiple-choice poll option.
+func GetPollOptionCountKey(option uint32) []byte {
+	key := make([]byte, len(KeyPollOptionCount)+4)
+	copy(key, KeyPollOptionCount)
+	binary.BigEndian.PutUint32(key[len(KeyPollOptionCount):], option)
+	return key
+}
+
+// GetPollOptionWeightKey returns the key (within KeyPollPrefix) of the answer weight sum of a multiple-choice poll
+// option.
+func GetPollOptionWeightKey(option uint32) []byte {
+	key := make([]byte, len(KeyPollOptionWeight)+4)
+	copy(key, KeyPollOptionWeight)
+	binary.BigEndian.PutUint32(key[len(KeyPollOptionWeight):], option)
+	return key
+}
@@ -14,11 +14,18 @@ var (
 	_ sdk.Msg                            = &MsgPropose{}
 	_ codectypes.UnpackInterfacesMessage = &MsgPropose{}
 	_ sdk.Msg                            = &MsgVote{}
+	_ sdk.Msg                            = &MsgVeto{}
+	_ sdk.Msg                            = &MsgCancelProposal{}
+	_ sdk.Msg                            = &MsgAmendProposal{}
+	_ codectypes.UnpackInterfacesMessage = &MsgAmendProposal{}
 )
 
 const (
 	ProposeConst    = "propose"
 	VoteConst       = "vote"
+	VetoConst       = "veto"
+	CancelConst     = "cancel_proposal"
+	AmendConst      = "amend_proposal"
 	StartPollConst  = "start_poll"
 	AnswerPollConst = "answer_poll"
 )
@@ -68,6 +75,9 @@ func (msg MsgVote) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return errors.Wrap(err, "invalid voter")
 	}
+	if msg.ProposalId == 0 {
+		return errors.New("invalid proposal_id: must be positive")
+	}
 	return nil
 }
 
@@ -91,6 +101,112 @@ func (msg MsgVote) GetVoter() sdk.AccAddress {
 	return addr
 }
 
+func (MsgVeto) Route() string { return RouterKey }
+
+func (MsgVeto) Type() string { return VetoConst }
+
+func (msg MsgVeto) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Governor); err != nil {
+		return errors.Wrap(err, "invalid governor")
+	}
+	if msg.ProposalId == 0 {
+		return errors.New("invalid proposal_id: must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgVeto) GetSignBytes() []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (msg MsgVeto) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetGovernor()}
+}
+
+func (msg MsgVeto) GetGovernor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Governor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (MsgCancelProposal) Route() string { return RouterKey }
+
+func (MsgCancelProposal) Type() string { return CancelConst }
+
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Author); err != nil {
+		return errors.Wrap(err, "invalid author")
+	}
+	if msg.ProposalId == 0 {
+		return errors.New("invalid proposal_id: must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgCancelProposal) GetSignBytes() []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetAuthor()}
+}
+
+func (msg MsgCancelProposal) GetAuthor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Author)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (msg MsgAmendProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
+
+func (MsgAmendProposal) Route() string { return RouterKey }
+
+func (MsgAmendProposal) Type() string { return AmendConst }
+
+func (msg MsgAmendProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Author); err != nil {
+		return errors.Wrap(err, "invalid author")
+	}
+	if msg.ProposalId == 0 {
+		return errors.New("invalid proposal_id: must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgAmendProposal) GetSignBytes() []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (msg MsgAmendProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetAuthor()}
+}
+
+func (msg MsgAmendProposal) GetAuthor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Author)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 func (MsgStartPoll) Route() string { return RouterKey }
 
 func (MsgStartPoll) Type() string { return StartPollConst }
@@ -102,6 +218,12 @@ func (msg MsgStartPoll) ValidateBasic() error {
 	if msg.Poll.EndTime != nil {
 		return errors.New("end_time should be empty")
 	}
+	if !msg.Poll.Deposit.Empty() {
+		return errors.New("deposit should be empty")
+	}
+	if msg.Poll.Author != msg.Author {
+		return errors.New("author should be the poll author")
+	}
 	return msg.Poll.Validate()
 }
 
@@ -170,3 +292,17 @@ func NewMsgPropose(
 
 	return m, nil
 }
+
+func NewMsgAmendProposal(messages []sdk.Msg, author string, id uint64, name string) (*MsgAmendProposal, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgAmendProposal{
+		Author:     author,
+		ProposalId: id,
+		Messages:   anys,
+		Name:       name,
+	}, nil
+}
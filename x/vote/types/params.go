@@ -2,20 +2,41 @@ package types
 
 import (
 	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
+
+	"github.com/axiome-pro/axm-node/util"
 )
 
 // Parameter store keys
 var (
-	DefaultvotePeriod int32 = 24 * 60
+	DefaultvotePeriod     int32 = 24 * 60
+	DefaultThreshold            = util.NewFraction(2, 3)
+	DefaultQuorum               = util.FractionInt(1)
+	DefaultExecutionDelay int32 = 0
+	DefaultVetoShare            = util.NewFraction(1, 3)
+
+	DefaultMinPollParticipation uint64 = 0
 )
 
 // NewParams creates a new Params object
-func NewParams(votePeriod, pollPeriod int32) Params {
+func NewParams(
+	votePeriod, pollPeriod int32, threshold, quorum util.Fraction, msgRules []MsgRule,
+	executionDelay int32, vetoShare util.Fraction, minPollDeposit sdk.Coins, minPollParticipation uint64,
+) Params {
 	return Params{
-		VotePeriod: votePeriod,
-		PollPeriod: pollPeriod,
+		VotePeriod:           votePeriod,
+		PollPeriod:           pollPeriod,
+		Threshold:            threshold,
+		Quorum:               quorum,
+		MsgRules:             msgRules,
+		ExecutionDelay:       executionDelay,
+		VetoShare:            vetoShare,
+		MinPollDeposit:       minPollDeposit,
+		MinPollParticipation: minPollParticipation,
 	}
 }
 
@@ -30,7 +51,10 @@ func (p Params) String() string {
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(DefaultvotePeriod, DefaultvotePeriod)
+	return NewParams(
+		DefaultvotePeriod, DefaultvotePeriod, DefaultThreshold, DefaultQuorum, nil,
+		DefaultExecutionDelay, DefaultVetoShare, nil, DefaultMinPollParticipation,
+	)
 }
 
 func (p Params) Validate() error {
@@ -40,9 +64,66 @@ func (p Params) Validate() error {
 	if err := validatevotePeriod(p.PollPeriod); err != nil {
 		return errors.Wrap(err, "invalid poll_period")
 	}
+	if err := validateShare(p.Threshold); err != nil {
+		return errors.Wrap(err, "invalid threshold")
+	}
+	if err := validateShare(p.Quorum); err != nil {
+		return errors.Wrap(err, "invalid quorum")
+	}
+	if p.ExecutionDelay < 0 {
+		return fmt.Errorf("invalid execution_delay: must not be negative: %d", p.ExecutionDelay)
+	}
+	if err := validateShare(p.VetoShare); err != nil {
+		return errors.Wrap(err, "invalid veto_share")
+	}
+	if err := p.MinPollDeposit.Validate(); err != nil {
+		return errors.Wrap(err, "invalid min_poll_deposit")
+	}
+	seen := make(map[string]bool, len(p.MsgRules))
+	for i, rule := range p.MsgRules {
+		if rule.MsgTypeUrl == "" {
+			return errors.Errorf("invalid msg_rules (item #%d): empty msg_type_url", i)
+		}
+		if seen[rule.MsgTypeUrl] {
+			return errors.Errorf("invalid msg_rules (item #%d): duplicate msg_type_url %s", i, rule.MsgTypeUrl)
+		}
+		seen[rule.MsgTypeUrl] = true
+		if err := validateShare(rule.Threshold); err != nil {
+			return errors.Wrapf(err, "invalid msg_rules (item #%d): invalid threshold", i)
+		}
+		if err := validateShare(rule.Quorum); err != nil {
+			return errors.Wrapf(err, "invalid msg_rules (item #%d): invalid quorum", i)
+		}
+	}
 	return nil
 }
 
+// DecisionRule returns the threshold and the quorum a proposal carrying the messages is decided by. If the messages
+// have different rules, the strictest threshold and quorum among them are taken.
+func (p Params) DecisionRule(msgs []*codectypes.Any) (threshold, quorum util.Fraction) {
+	rules := make(map[string]MsgRule, len(p.MsgRules))
+	for _, rule := range p.MsgRules {
+		rules[rule.MsgTypeUrl] = rule
+	}
+
+	if len(msgs) == 0 {
+		return p.Threshold, p.Quorum
+	}
+	for i, msg := range msgs {
+		rule, ok := rules[msg.TypeUrl]
+		if !ok {
+			rule = MsgRule{Threshold: p.Threshold, Quorum: p.Quorum}
+		}
+		if i == 0 || rule.Threshold.GT(threshold) {
+			threshold = rule.Threshold
+		}
+		if i == 0 || rule.Quorum.GT(quorum) {
+			quorum = rule.Quorum
+		}
+	}
+	return threshold, quorum
+}
+
 func validatevotePeriod(i interface{}) error {
 	v, ok := i.(int32)
 	if !ok {
@@ -55,3 +136,13 @@ func validatevotePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validateShare(x util.Fraction) error {
+	if x.IsNullValue() {
+		return errors.New("must be set")
+	}
+	if !x.IsPositive() || x.GT(util.FractionInt(1)) {
+		return fmt.Errorf("must be in (0; 1]: %s", x)
+	}
+	return nil
+}
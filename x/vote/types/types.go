@@ -42,6 +42,43 @@ func (p Proposal) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(p.Author); err != nil {
 		return errors.Wrap(err, "invalid author")
 	}
+	if p.Id == 0 {
+		return errors.New("invalid id: must be positive")
+	}
+	return nil
+}
+
+func (p ActiveProposal) GetAgreed() Government {
+	return Government{Members: p.Agreed}
+}
+
+func (p ActiveProposal) GetDisagreed() Government {
+	return Government{Members: p.Disagreed}
+}
+
+func (p ActiveProposal) Validate() error {
+	if err := p.Proposal.Validate(); err != nil {
+		return errors.Wrap(err, "invalid proposal")
+	}
+	if p.Proposal.Status != PROPOSAL_STATUS_VOTING {
+		return errors.New("invalid status: must be voting")
+	}
+	if p.Proposal.EndTime == nil {
+		return errors.New("invalid end_time: must be set")
+	}
+	if p.Proposal.StartBlock <= 0 {
+		return errors.New("invalid start_block: must be positive")
+	}
+	for i, bech32 := range p.Agreed {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid agreed (item #%d)", i)
+		}
+	}
+	for i, bech32 := range p.Disagreed {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid disagreed (item #%d)", i)
+		}
+	}
 	return nil
 }
 
@@ -140,9 +177,46 @@ func (r ProposalHistoryRecord) Validate() error {
 	if r.Finished <= 0 {
 		return errors.New("invalid finished: must be positive")
 	}
+	switch r.Proposal.Status {
+	case PROPOSAL_STATUS_PASSED, PROPOSAL_STATUS_REJECTED, PROPOSAL_STATUS_VETOED, PROPOSAL_STATUS_CANCELLED:
+	default:
+		return errors.New("invalid status: must be passed, rejected, vetoed or cancelled")
+	}
+	if r.Proposal.Status != PROPOSAL_STATUS_PASSED && r.ExecutionStatus != EXECUTION_STATUS_NOT_EXECUTED {
+		return errors.New("invalid execution_status: only passed proposals are executed")
+	}
+	if r.ExecutionStatus == EXECUTION_STATUS_FAILED && r.Error == "" {
+		return errors.New("invalid error: must be set for a failed execution")
+	}
+	return nil
+}
+
+func (q QueuedProposal) GetVetoed() Government {
+	return Government{Members: q.Vetoed}
+}
+
+func (q QueuedProposal) Validate() error {
+	if err := q.Record.Proposal.Validate(); err != nil {
+		return errors.Wrap(err, "invalid proposal")
+	}
+	if q.Record.Proposal.Status != PROPOSAL_STATUS_QUEUED {
+		return errors.New("invalid status: must be queued")
+	}
+	if q.Eta.IsZero() {
+		return errors.New("invalid eta: must be set")
+	}
+	for i, bech32 := range q.Vetoed {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid vetoed (item #%d)", i)
+		}
+	}
 	return nil
 }
 
+// MaxPollQueueLength is the number of polls that can wait for the current one to end. Further polls are refused
+// until the queue moves, so deposits can't get locked behind an endless queue.
+const MaxPollQueueLength = 10
+
 func NewPollValidators(author sdk.AccAddress, name, text string, quorum util.Fraction) Poll {
 	return Poll{
 		Name:         name,
@@ -192,7 +266,34 @@ func (p Poll) Validate() error {
 	if p.StartTime != nil && p.EndTime != nil && !p.EndTime.After(*p.StartTime) {
 		return errors.New("start_time after end_time")
 	}
+	if _, ok := PollWeighting_name[int32(p.Weighting)]; !ok {
+		return errors.New("unknown weighting")
+	}
+	if _, ok := p.Requirements.(*Poll_CanValidate); !ok && p.Weighting == POLL_WEIGHTING_VOTING_POWER {
+		return errors.New("voting power weighting requires can_validate")
+	}
+	if p.IsMultipleChoice() {
+		if len(p.Options) < 2 {
+			return errors.New("a multiple-choice poll must have at least 2 options")
+		}
+		seen := make(map[string]bool, len(p.Options))
+		for i, option := range p.Options {
+			if option == "" {
+				return errors.Errorf("option #%d: empty string", i)
+			}
+			if seen[option] {
+				return errors.Errorf("option #%d: duplicate %q", i, option)
+			}
+			seen[option] = true
+		}
+	}
 	return nil
 }
 
+// IsMultipleChoice tells whether the poll is answered with one of its options rather than yes/no.
+func (p Poll) IsMultipleChoice() bool { return len(p.Options) != 0 }
+
+// IsWeighted tells whether the answers to the poll are weighted rather than counted one per account.
+func (p Poll) IsWeighted() bool { return p.Weighting != POLL_WEIGHTING_ACCOUNT }
+
 func (u *Poll_Unit) Equal(other *Poll_Unit) bool { return (u == nil) == (other == nil) }